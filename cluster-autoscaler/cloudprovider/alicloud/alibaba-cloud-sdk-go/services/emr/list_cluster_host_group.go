@@ -0,0 +1,140 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package emr
+
+import (
+	"context"
+
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/requests"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/responses"
+)
+
+// ListClusterHostGroup invokes the emr.ListClusterHostGroup API synchronously
+// api document: https://help.aliyun.com/api/emr/listclusterhostgroup.html
+func (client *Client) ListClusterHostGroup(request *ListClusterHostGroupRequest) (response *ListClusterHostGroupResponse, err error) {
+	response = CreateListClusterHostGroupResponse()
+	err = client.doActionWithRetry(context.Background(), request, response)
+	return
+}
+
+// ListClusterHostGroupWithChan invokes the emr.ListClusterHostGroup API asynchronously
+// api document: https://help.aliyun.com/api/emr/listclusterhostgroup.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) ListClusterHostGroupWithChan(request *ListClusterHostGroupRequest) (<-chan *ListClusterHostGroupResponse, <-chan error) {
+	responseChan := make(chan *ListClusterHostGroupResponse, 1)
+	errChan := make(chan error, 1)
+	err := client.AddAsyncTask(func() {
+		defer close(responseChan)
+		defer close(errChan)
+		response, err := client.ListClusterHostGroup(request)
+		if err != nil {
+			errChan <- err
+		} else {
+			responseChan <- response
+		}
+	})
+	if err != nil {
+		errChan <- err
+		close(responseChan)
+		close(errChan)
+	}
+	return responseChan, errChan
+}
+
+// ListClusterHostGroupWithCallback invokes the emr.ListClusterHostGroup API asynchronously
+// api document: https://help.aliyun.com/api/emr/listclusterhostgroup.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) ListClusterHostGroupWithCallback(request *ListClusterHostGroupRequest, callback func(response *ListClusterHostGroupResponse, err error)) <-chan int {
+	result := make(chan int, 1)
+	err := client.AddAsyncTask(func() {
+		var response *ListClusterHostGroupResponse
+		var err error
+		defer close(result)
+		response, err = client.ListClusterHostGroup(request)
+		callback(response, err)
+		result <- 1
+	})
+	if err != nil {
+		defer close(result)
+		callback(nil, err)
+		result <- 0
+	}
+	return result
+}
+
+// ListClusterHostGroupRequest is the request struct for api ListClusterHostGroup
+type ListClusterHostGroupRequest struct {
+	*requests.RpcRequest
+	ResourceOwnerId      requests.Integer `position:"Query" name:"ResourceOwnerId"`
+	ResourceOwnerAccount string           `position:"Query" name:"ResourceOwnerAccount"`
+	OwnerAccount         string           `position:"Query" name:"OwnerAccount"`
+	OwnerId              requests.Integer `position:"Query" name:"OwnerId"`
+	ClusterId            string           `position:"Query" name:"ClusterId"`
+	NodeGroupType        string           `position:"Query" name:"NodeGroupType"`
+}
+
+// ListClusterHostGroupResponse is the response struct for api ListClusterHostGroup
+type ListClusterHostGroupResponse struct {
+	*responses.BaseResponse
+	RequestId     string        `json:"RequestId" xml:"RequestId"`
+	HostGroupList HostGroupList `json:"HostGroupList" xml:"HostGroupList"`
+}
+
+// CreateListClusterHostGroupRequest creates a request to invoke ListClusterHostGroup API
+func CreateListClusterHostGroupRequest() (request *ListClusterHostGroupRequest) {
+	request = &ListClusterHostGroupRequest{
+		RpcRequest: &requests.RpcRequest{},
+	}
+	request.InitWithApiInfo("Emr", "2016-04-08", "ListClusterHostGroup", "emr", "openAPI")
+	return
+}
+
+// CreateListClusterHostGroupResponse creates a response to parse from ListClusterHostGroup response
+func CreateListClusterHostGroupResponse() (response *ListClusterHostGroupResponse) {
+	response = &ListClusterHostGroupResponse{
+		BaseResponse: &responses.BaseResponse{},
+	}
+	return
+}
+
+// HostGroupList is a nested struct in emr response
+type HostGroupList struct {
+	HostGroup []HostGroup `json:"HostGroup" xml:"HostGroup"`
+}
+
+// HostGroup is a nested struct in emr response
+type HostGroup struct {
+	ClusterId     string   `json:"ClusterId" xml:"ClusterId"`
+	NodeGroupId   string   `json:"NodeGroupId" xml:"NodeGroupId"`
+	NodeGroupType string   `json:"NodeGroupType" xml:"NodeGroupType"`
+	NodeCount     int      `json:"NodeCount" xml:"NodeCount"`
+	HostGroupName string   `json:"HostGroupName" xml:"HostGroupName"`
+	HostList      HostList `json:"HostList" xml:"HostList"`
+}
+
+// HostList is a nested struct in emr response
+type HostList struct {
+	Host []Host `json:"Host" xml:"Host"`
+}
+
+// Host is a nested struct in emr response, describing a single EMR host
+// within a HostGroup.
+type Host struct {
+	InstanceId string `json:"InstanceId" xml:"InstanceId"`
+	HostName   string `json:"HostName" xml:"HostName"`
+	Status     string `json:"Status" xml:"Status"`
+}
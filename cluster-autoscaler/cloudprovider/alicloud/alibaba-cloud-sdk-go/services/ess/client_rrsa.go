@@ -0,0 +1,51 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ess
+
+// NewClientWithRRSA creates a client backed by RAM Roles for Service Accounts
+// (RRSA) federation: it reads the projected ServiceAccount token at
+// oidcTokenFilePath and exchanges it for STS credentials via
+// AssumeRoleWithOIDC against roleARN/oidcProviderARN, refreshing the
+// credentials automatically before they expire. This lets pods running
+// under EKS/ACK workload identity call the ess API without a long-lived
+// AccessKey.
+func NewClientWithRRSA(regionId, roleARN, oidcProviderARN, oidcTokenFilePath, roleSessionName string) (client *Client, err error) {
+	provider := newOIDCCredentialsProvider(regionId, roleARN, oidcProviderARN, oidcTokenFilePath, roleSessionName)
+	return NewClientWithProvider(regionId, provider)
+}
+
+// NewClientWithProvider creates a client whose credentials are supplied by an
+// arbitrary CredentialsProvider, e.g. a custom chain combining RRSA with a
+// static fallback AccessKey.
+func NewClientWithProvider(regionId string, provider Provider, configs ...func(*Client)) (client *Client, err error) {
+	client = &Client{}
+	for _, config := range configs {
+		config(client)
+	}
+	err = client.InitWithProvider(regionId, provider)
+	return
+}
+
+// NewClientWithEcsRamRoleAndPolicy creates a client that assumes the
+// instance's ECS RAM role and further restricts the resulting session via an
+// inline policy document, mirroring the other NewClientWithEcsRamRole*
+// constructors in this package.
+func NewClientWithEcsRamRoleAndPolicy(regionId, accessKeyId, accessKeySecret, roleArn, rolePolicy string) (client *Client, err error) {
+	client = &Client{}
+	err = client.InitWithEcsRamRoleAndPolicy(regionId, accessKeyId, accessKeySecret, roleArn, rolePolicy)
+	return
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ess
+
+import (
+	"context"
+
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/requests"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/responses"
+)
+
+// CreateAlarm invokes the ess.CreateAlarm API synchronously
+// api document: https://help.aliyun.com/api/ess/createalarm.html
+func (client *Client) CreateAlarm(request *CreateAlarmRequest) (response *CreateAlarmResponse, err error) {
+	return client.CreateAlarmWithContext(context.Background(), request)
+}
+
+// CreateAlarmWithContext invokes the ess.CreateAlarm API synchronously,
+// honoring ctx cancellation across the RetryPolicy's backoff and rate-limit waits.
+// api document: https://help.aliyun.com/api/ess/createalarm.html
+func (client *Client) CreateAlarmWithContext(ctx context.Context, request *CreateAlarmRequest) (response *CreateAlarmResponse, err error) {
+	response = CreateCreateAlarmResponse()
+	err = client.doActionWithRetry(ctx, request, response)
+	return
+}
+
+// CreateAlarmWithChan invokes the ess.CreateAlarm API asynchronously
+// api document: https://help.aliyun.com/api/ess/createalarm.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) CreateAlarmWithChan(request *CreateAlarmRequest) (<-chan *CreateAlarmResponse, <-chan error) {
+	return client.CreateAlarmWithChanContext(context.Background(), request)
+}
+
+// CreateAlarmWithChanContext invokes the ess.CreateAlarm API asynchronously,
+// sending ctx.Err() on the error channel instead of issuing the request once ctx is already canceled.
+// api document: https://help.aliyun.com/api/ess/createalarm.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) CreateAlarmWithChanContext(ctx context.Context, request *CreateAlarmRequest) (<-chan *CreateAlarmResponse, <-chan error) {
+	responseChan := make(chan *CreateAlarmResponse, 1)
+	errChan := make(chan error, 1)
+	err := client.AddAsyncTask(func() {
+		defer close(responseChan)
+		defer close(errChan)
+		response, err := client.CreateAlarmWithContext(ctx, request)
+		if ctx.Err() != nil {
+			errChan <- ctx.Err()
+			return
+		}
+		if err != nil {
+			errChan <- err
+		} else {
+			responseChan <- response
+		}
+	})
+	if err != nil {
+		errChan <- err
+		close(responseChan)
+		close(errChan)
+	}
+	return responseChan, errChan
+}
+
+// CreateAlarmWithCallback invokes the ess.CreateAlarm API asynchronously
+// api document: https://help.aliyun.com/api/ess/createalarm.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) CreateAlarmWithCallback(request *CreateAlarmRequest, callback func(response *CreateAlarmResponse, err error)) <-chan int {
+	return client.CreateAlarmWithCallbackContext(context.Background(), request, callback)
+}
+
+// CreateAlarmWithCallbackContext invokes the ess.CreateAlarm API asynchronously,
+// calling callback with ctx.Err() instead of issuing the request once ctx is
+// already canceled.
+// api document: https://help.aliyun.com/api/ess/createalarm.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) CreateAlarmWithCallbackContext(ctx context.Context, request *CreateAlarmRequest, callback func(response *CreateAlarmResponse, err error)) <-chan int {
+	result := make(chan int, 1)
+	err := client.AddAsyncTask(func() {
+		var response *CreateAlarmResponse
+		var err error
+		defer close(result)
+		response, err = client.CreateAlarmWithContext(ctx, request)
+		if ctx.Err() != nil {
+			callback(nil, ctx.Err())
+			result <- 0
+			return
+		}
+		callback(response, err)
+		result <- 1
+	})
+	if err != nil {
+		defer close(result)
+		callback(nil, err)
+		result <- 0
+	}
+	return result
+}
+
+// CreateAlarmRequest is the request struct for api CreateAlarm
+type CreateAlarmRequest struct {
+	*requests.RpcRequest
+	ResourceOwnerId          requests.Integer `position:"Query" name:"ResourceOwnerId"`
+	ResourceOwnerAccount     string           `position:"Query" name:"ResourceOwnerAccount"`
+	OwnerAccount             string           `position:"Query" name:"OwnerAccount"`
+	OwnerId                  requests.Integer `position:"Query" name:"OwnerId"`
+	Name                     string           `position:"Query" name:"Name"`
+	ScalingGroupId           string           `position:"Query" name:"ScalingGroupId"`
+	MetricType               string           `position:"Query" name:"MetricType"`
+	MetricName               string           `position:"Query" name:"MetricName"`
+	Period                   requests.Integer `position:"Query" name:"Period"`
+	Statistics               string           `position:"Query" name:"Statistics"`
+	Threshold                requests.Float   `position:"Query" name:"Threshold"`
+	ComparisonOperator       string           `position:"Query" name:"ComparisonOperator"`
+	EvaluationCount          requests.Integer `position:"Query" name:"EvaluationCount"`
+	Description              string           `position:"Query" name:"Description"`
+	Dimension1DimensionKey   string           `position:"Query" name:"Dimension.1.DimensionKey"`
+	Dimension1DimensionValue string           `position:"Query" name:"Dimension.1.DimensionValue"`
+	Dimension2DimensionKey   string           `position:"Query" name:"Dimension.2.DimensionKey"`
+	Dimension2DimensionValue string           `position:"Query" name:"Dimension.2.DimensionValue"`
+	Dimension3DimensionKey   string           `position:"Query" name:"Dimension.3.DimensionKey"`
+	Dimension3DimensionValue string           `position:"Query" name:"Dimension.3.DimensionValue"`
+	AlarmAction1             string           `position:"Query" name:"AlarmAction.1"`
+	AlarmAction2             string           `position:"Query" name:"AlarmAction.2"`
+	AlarmAction3             string           `position:"Query" name:"AlarmAction.3"`
+	AlarmAction4             string           `position:"Query" name:"AlarmAction.4"`
+	AlarmAction5             string           `position:"Query" name:"AlarmAction.5"`
+}
+
+// CreateAlarmResponse is the response struct for api CreateAlarm
+type CreateAlarmResponse struct {
+	*responses.BaseResponse
+	RequestId   string `json:"RequestId" xml:"RequestId"`
+	AlarmTaskId string `json:"AlarmTaskId" xml:"AlarmTaskId"`
+}
+
+// CreateCreateAlarmRequest creates a request to invoke CreateAlarm API
+func CreateCreateAlarmRequest() (request *CreateAlarmRequest) {
+	request = &CreateAlarmRequest{
+		RpcRequest: &requests.RpcRequest{},
+	}
+	request.InitWithApiInfo("Ess", "2014-08-28", "CreateAlarm", "ess", "openAPI")
+	return
+}
+
+// CreateCreateAlarmResponse creates a response to parse from CreateAlarm response
+func CreateCreateAlarmResponse() (response *CreateAlarmResponse) {
+	response = &CreateAlarmResponse{
+		BaseResponse: &responses.BaseResponse{},
+	}
+	return
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ess
+
+import (
+	"context"
+
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/requests"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/responses"
+)
+
+// CreateScalingConfiguration invokes the ess.CreateScalingConfiguration API synchronously
+// api document: https://help.aliyun.com/api/ess/createscalingconfiguration.html
+func (client *Client) CreateScalingConfiguration(request *CreateScalingConfigurationRequest) (response *CreateScalingConfigurationResponse, err error) {
+	return client.CreateScalingConfigurationWithContext(context.Background(), request)
+}
+
+// CreateScalingConfigurationWithContext invokes the ess.CreateScalingConfiguration API synchronously,
+// honoring ctx cancellation across the RetryPolicy's backoff and rate-limit waits.
+// api document: https://help.aliyun.com/api/ess/createscalingconfiguration.html
+func (client *Client) CreateScalingConfigurationWithContext(ctx context.Context, request *CreateScalingConfigurationRequest) (response *CreateScalingConfigurationResponse, err error) {
+	response = CreateCreateScalingConfigurationResponse()
+	err = client.doActionWithRetry(ctx, request, response)
+	return
+}
+
+// CreateScalingConfigurationWithChan invokes the ess.CreateScalingConfiguration API asynchronously
+// api document: https://help.aliyun.com/api/ess/createscalingconfiguration.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) CreateScalingConfigurationWithChan(request *CreateScalingConfigurationRequest) (<-chan *CreateScalingConfigurationResponse, <-chan error) {
+	return client.CreateScalingConfigurationWithChanContext(context.Background(), request)
+}
+
+// CreateScalingConfigurationWithChanContext invokes the ess.CreateScalingConfiguration API asynchronously,
+// sending ctx.Err() on the error channel instead of issuing the request once ctx is already canceled.
+// api document: https://help.aliyun.com/api/ess/createscalingconfiguration.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) CreateScalingConfigurationWithChanContext(ctx context.Context, request *CreateScalingConfigurationRequest) (<-chan *CreateScalingConfigurationResponse, <-chan error) {
+	responseChan := make(chan *CreateScalingConfigurationResponse, 1)
+	errChan := make(chan error, 1)
+	err := client.AddAsyncTask(func() {
+		defer close(responseChan)
+		defer close(errChan)
+		response, err := client.CreateScalingConfigurationWithContext(ctx, request)
+		if ctx.Err() != nil {
+			errChan <- ctx.Err()
+			return
+		}
+		if err != nil {
+			errChan <- err
+		} else {
+			responseChan <- response
+		}
+	})
+	if err != nil {
+		errChan <- err
+		close(responseChan)
+		close(errChan)
+	}
+	return responseChan, errChan
+}
+
+// CreateScalingConfigurationWithCallback invokes the ess.CreateScalingConfiguration API asynchronously
+// api document: https://help.aliyun.com/api/ess/createscalingconfiguration.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) CreateScalingConfigurationWithCallback(request *CreateScalingConfigurationRequest, callback func(response *CreateScalingConfigurationResponse, err error)) <-chan int {
+	return client.CreateScalingConfigurationWithCallbackContext(context.Background(), request, callback)
+}
+
+// CreateScalingConfigurationWithCallbackContext invokes the ess.CreateScalingConfiguration API asynchronously,
+// calling callback with ctx.Err() instead of issuing the request once ctx is
+// already canceled.
+// api document: https://help.aliyun.com/api/ess/createscalingconfiguration.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) CreateScalingConfigurationWithCallbackContext(ctx context.Context, request *CreateScalingConfigurationRequest, callback func(response *CreateScalingConfigurationResponse, err error)) <-chan int {
+	result := make(chan int, 1)
+	err := client.AddAsyncTask(func() {
+		var response *CreateScalingConfigurationResponse
+		var err error
+		defer close(result)
+		response, err = client.CreateScalingConfigurationWithContext(ctx, request)
+		if ctx.Err() != nil {
+			callback(nil, ctx.Err())
+			result <- 0
+			return
+		}
+		callback(response, err)
+		result <- 1
+	})
+	if err != nil {
+		defer close(result)
+		callback(nil, err)
+		result <- 0
+	}
+	return result
+}
+
+// CreateScalingConfigurationRequest is the request struct for api CreateScalingConfiguration
+type CreateScalingConfigurationRequest struct {
+	*requests.RpcRequest
+	ResourceOwnerId            requests.Integer `position:"Query" name:"ResourceOwnerId"`
+	ResourceOwnerAccount       string           `position:"Query" name:"ResourceOwnerAccount"`
+	OwnerAccount               string           `position:"Query" name:"OwnerAccount"`
+	OwnerId                    requests.Integer `position:"Query" name:"OwnerId"`
+	ScalingGroupId             string           `position:"Query" name:"ScalingGroupId"`
+	ImageId                    string           `position:"Query" name:"ImageId"`
+	InstanceType               string           `position:"Query" name:"InstanceType"`
+	InstanceType1              string           `position:"Query" name:"InstanceTypes.1"`
+	InstanceType2              string           `position:"Query" name:"InstanceTypes.2"`
+	InstanceType3              string           `position:"Query" name:"InstanceTypes.3"`
+	SecurityGroupId            string           `position:"Query" name:"SecurityGroupId"`
+	ScalingConfigurationName   string           `position:"Query" name:"ScalingConfigurationName"`
+	InternetChargeType         string           `position:"Query" name:"InternetChargeType"`
+	InternetMaxBandwidthIn     requests.Integer `position:"Query" name:"InternetMaxBandwidthIn"`
+	InternetMaxBandwidthOut    requests.Integer `position:"Query" name:"InternetMaxBandwidthOut"`
+	SystemDiskCategory         string           `position:"Query" name:"SystemDisk.Category"`
+	SystemDiskSize             requests.Integer `position:"Query" name:"SystemDisk.Size"`
+	DataDisk1Size              requests.Integer `position:"Query" name:"DataDisk.1.Size"`
+	DataDisk1Category          string           `position:"Query" name:"DataDisk.1.Category"`
+	DataDisk1SnapshotId        string           `position:"Query" name:"DataDisk.1.SnapshotId"`
+	DataDisk1Device            string           `position:"Query" name:"DataDisk.1.Device"`
+	DataDisk2Size              requests.Integer `position:"Query" name:"DataDisk.2.Size"`
+	DataDisk2Category          string           `position:"Query" name:"DataDisk.2.Category"`
+	DataDisk2SnapshotId        string           `position:"Query" name:"DataDisk.2.SnapshotId"`
+	DataDisk2Device            string           `position:"Query" name:"DataDisk.2.Device"`
+	IoOptimized                string           `position:"Query" name:"IoOptimized"`
+	KeyPairName                string           `position:"Query" name:"KeyPairName"`
+	RamRoleName                string           `position:"Query" name:"RamRoleName"`
+	UserData                   string           `position:"Query" name:"UserData"`
+	Tags                       string           `position:"Query" name:"Tags"`
+	SpotStrategy               string           `position:"Query" name:"SpotStrategy"`
+	SpotPriceLimit             requests.Float   `position:"Query" name:"SpotPriceLimit"`
+}
+
+// CreateScalingConfigurationResponse is the response struct for api CreateScalingConfiguration
+type CreateScalingConfigurationResponse struct {
+	*responses.BaseResponse
+	RequestId              string `json:"RequestId" xml:"RequestId"`
+	ScalingConfigurationId string `json:"ScalingConfigurationId" xml:"ScalingConfigurationId"`
+}
+
+// CreateCreateScalingConfigurationRequest creates a request to invoke CreateScalingConfiguration API
+func CreateCreateScalingConfigurationRequest() (request *CreateScalingConfigurationRequest) {
+	request = &CreateScalingConfigurationRequest{
+		RpcRequest: &requests.RpcRequest{},
+	}
+	request.InitWithApiInfo("Ess", "2014-08-28", "CreateScalingConfiguration", "ess", "openAPI")
+	return
+}
+
+// CreateCreateScalingConfigurationResponse creates a response to parse from CreateScalingConfiguration response
+func CreateCreateScalingConfigurationResponse() (response *CreateScalingConfigurationResponse) {
+	response = &CreateScalingConfigurationResponse{
+		BaseResponse: &responses.BaseResponse{},
+	}
+	return
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ess
+
+import (
+	"context"
+
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/requests"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/responses"
+)
+
+// DescribeAlarms invokes the ess.DescribeAlarms API synchronously
+// api document: https://help.aliyun.com/api/ess/describealarms.html
+func (client *Client) DescribeAlarms(request *DescribeAlarmsRequest) (response *DescribeAlarmsResponse, err error) {
+	return client.DescribeAlarmsWithContext(context.Background(), request)
+}
+
+// DescribeAlarmsWithContext invokes the ess.DescribeAlarms API synchronously,
+// honoring ctx cancellation across the RetryPolicy's backoff and rate-limit waits.
+// api document: https://help.aliyun.com/api/ess/describealarms.html
+func (client *Client) DescribeAlarmsWithContext(ctx context.Context, request *DescribeAlarmsRequest) (response *DescribeAlarmsResponse, err error) {
+	response = CreateDescribeAlarmsResponse()
+	err = client.doActionWithRetry(ctx, request, response)
+	return
+}
+
+// DescribeAlarmsWithChan invokes the ess.DescribeAlarms API asynchronously
+// api document: https://help.aliyun.com/api/ess/describealarms.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) DescribeAlarmsWithChan(request *DescribeAlarmsRequest) (<-chan *DescribeAlarmsResponse, <-chan error) {
+	return client.DescribeAlarmsWithChanContext(context.Background(), request)
+}
+
+// DescribeAlarmsWithChanContext invokes the ess.DescribeAlarms API asynchronously,
+// sending ctx.Err() on the error channel instead of issuing the request once ctx is already canceled.
+// api document: https://help.aliyun.com/api/ess/describealarms.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) DescribeAlarmsWithChanContext(ctx context.Context, request *DescribeAlarmsRequest) (<-chan *DescribeAlarmsResponse, <-chan error) {
+	responseChan := make(chan *DescribeAlarmsResponse, 1)
+	errChan := make(chan error, 1)
+	err := client.AddAsyncTask(func() {
+		defer close(responseChan)
+		defer close(errChan)
+		response, err := client.DescribeAlarmsWithContext(ctx, request)
+		if ctx.Err() != nil {
+			errChan <- ctx.Err()
+			return
+		}
+		if err != nil {
+			errChan <- err
+		} else {
+			responseChan <- response
+		}
+	})
+	if err != nil {
+		errChan <- err
+		close(responseChan)
+		close(errChan)
+	}
+	return responseChan, errChan
+}
+
+// DescribeAlarmsWithCallback invokes the ess.DescribeAlarms API asynchronously
+// api document: https://help.aliyun.com/api/ess/describealarms.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) DescribeAlarmsWithCallback(request *DescribeAlarmsRequest, callback func(response *DescribeAlarmsResponse, err error)) <-chan int {
+	return client.DescribeAlarmsWithCallbackContext(context.Background(), request, callback)
+}
+
+// DescribeAlarmsWithCallbackContext invokes the ess.DescribeAlarms API asynchronously,
+// calling callback with ctx.Err() instead of issuing the request once ctx is
+// already canceled.
+// api document: https://help.aliyun.com/api/ess/describealarms.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) DescribeAlarmsWithCallbackContext(ctx context.Context, request *DescribeAlarmsRequest, callback func(response *DescribeAlarmsResponse, err error)) <-chan int {
+	result := make(chan int, 1)
+	err := client.AddAsyncTask(func() {
+		var response *DescribeAlarmsResponse
+		var err error
+		defer close(result)
+		response, err = client.DescribeAlarmsWithContext(ctx, request)
+		if ctx.Err() != nil {
+			callback(nil, ctx.Err())
+			result <- 0
+			return
+		}
+		callback(response, err)
+		result <- 1
+	})
+	if err != nil {
+		defer close(result)
+		callback(nil, err)
+		result <- 0
+	}
+	return result
+}
+
+// DescribeAlarmsRequest is the request struct for api DescribeAlarms
+type DescribeAlarmsRequest struct {
+	*requests.RpcRequest
+	ResourceOwnerId      requests.Integer `position:"Query" name:"ResourceOwnerId"`
+	ResourceOwnerAccount string           `position:"Query" name:"ResourceOwnerAccount"`
+	OwnerAccount         string           `position:"Query" name:"OwnerAccount"`
+	OwnerId              requests.Integer `position:"Query" name:"OwnerId"`
+	ScalingGroupId       string           `position:"Query" name:"ScalingGroupId"`
+	AlarmTaskId          string           `position:"Query" name:"AlarmTaskId"`
+	Name                 string           `position:"Query" name:"Name"`
+	MetricName           string           `position:"Query" name:"MetricName"`
+	State                string           `position:"Query" name:"State"`
+	PageNumber           requests.Integer `position:"Query" name:"PageNumber"`
+	PageSize             requests.Integer `position:"Query" name:"PageSize"`
+}
+
+// DescribeAlarmsResponse is the response struct for api DescribeAlarms
+type DescribeAlarmsResponse struct {
+	*responses.BaseResponse
+	TotalCount int       `json:"TotalCount" xml:"TotalCount"`
+	PageNumber int       `json:"PageNumber" xml:"PageNumber"`
+	PageSize   int       `json:"PageSize" xml:"PageSize"`
+	RequestId  string    `json:"RequestId" xml:"RequestId"`
+	AlarmList  AlarmList `json:"AlarmList" xml:"AlarmList"`
+}
+
+// CreateDescribeAlarmsRequest creates a request to invoke DescribeAlarms API
+func CreateDescribeAlarmsRequest() (request *DescribeAlarmsRequest) {
+	request = &DescribeAlarmsRequest{
+		RpcRequest: &requests.RpcRequest{},
+	}
+	request.InitWithApiInfo("Ess", "2014-08-28", "DescribeAlarms", "ess", "openAPI")
+	return
+}
+
+// CreateDescribeAlarmsResponse creates a response to parse from DescribeAlarms response
+func CreateDescribeAlarmsResponse() (response *DescribeAlarmsResponse) {
+	response = &DescribeAlarmsResponse{
+		BaseResponse: &responses.BaseResponse{},
+	}
+	return
+}
+
+// AlarmList is a nested struct in ess response
+type AlarmList struct {
+	Alarm []Alarm `json:"Alarm" xml:"Alarm"`
+}
+
+// Alarm is a nested struct in ess response
+type Alarm struct {
+	AlarmTaskId        string       `json:"AlarmTaskId" xml:"AlarmTaskId"`
+	Name               string       `json:"Name" xml:"Name"`
+	ScalingGroupId     string       `json:"ScalingGroupId" xml:"ScalingGroupId"`
+	MetricType         string       `json:"MetricType" xml:"MetricType"`
+	MetricName         string       `json:"MetricName" xml:"MetricName"`
+	Period             int          `json:"Period" xml:"Period"`
+	Statistics         string       `json:"Statistics" xml:"Statistics"`
+	Threshold          float64      `json:"Threshold" xml:"Threshold"`
+	ComparisonOperator string       `json:"ComparisonOperator" xml:"ComparisonOperator"`
+	EvaluationCount    int          `json:"EvaluationCount" xml:"EvaluationCount"`
+	State              string       `json:"State" xml:"State"`
+	Enable             bool         `json:"Enable" xml:"Enable"`
+	Description        string       `json:"Description" xml:"Description"`
+	Dimensions         Dimensions   `json:"Dimensions" xml:"Dimensions"`
+	AlarmActions       AlarmActions `json:"AlarmActions" xml:"AlarmActions"`
+}
+
+// Dimensions is a nested struct in ess response
+type Dimensions struct {
+	Dimension []Dimension `json:"Dimension" xml:"Dimension"`
+}
+
+// Dimension is a nested struct in ess response
+type Dimension struct {
+	DimensionKey   string `json:"DimensionKey" xml:"DimensionKey"`
+	DimensionValue string `json:"DimensionValue" xml:"DimensionValue"`
+}
+
+// AlarmActions is a nested struct in ess response
+type AlarmActions struct {
+	AlarmAction []string `json:"AlarmAction" xml:"AlarmAction"`
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ess
+
+import (
+	"context"
+
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/requests"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/responses"
+)
+
+// DescribeScalingConfigurations invokes the ess.DescribeScalingConfigurations API synchronously
+// api document: https://help.aliyun.com/api/ess/describescalingconfigurations.html
+func (client *Client) DescribeScalingConfigurations(request *DescribeScalingConfigurationsRequest) (response *DescribeScalingConfigurationsResponse, err error) {
+	return client.DescribeScalingConfigurationsWithContext(context.Background(), request)
+}
+
+// DescribeScalingConfigurationsWithContext invokes the ess.DescribeScalingConfigurations API synchronously,
+// honoring ctx cancellation across the RetryPolicy's backoff and rate-limit waits.
+// api document: https://help.aliyun.com/api/ess/describescalingconfigurations.html
+func (client *Client) DescribeScalingConfigurationsWithContext(ctx context.Context, request *DescribeScalingConfigurationsRequest) (response *DescribeScalingConfigurationsResponse, err error) {
+	response = CreateDescribeScalingConfigurationsResponse()
+	err = client.doActionWithRetry(ctx, request, response)
+	return
+}
+
+// DescribeScalingConfigurationsWithChan invokes the ess.DescribeScalingConfigurations API asynchronously
+// api document: https://help.aliyun.com/api/ess/describescalingconfigurations.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) DescribeScalingConfigurationsWithChan(request *DescribeScalingConfigurationsRequest) (<-chan *DescribeScalingConfigurationsResponse, <-chan error) {
+	return client.DescribeScalingConfigurationsWithChanContext(context.Background(), request)
+}
+
+// DescribeScalingConfigurationsWithChanContext invokes the ess.DescribeScalingConfigurations API asynchronously,
+// sending ctx.Err() on the error channel instead of issuing the request once ctx is already canceled.
+// api document: https://help.aliyun.com/api/ess/describescalingconfigurations.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) DescribeScalingConfigurationsWithChanContext(ctx context.Context, request *DescribeScalingConfigurationsRequest) (<-chan *DescribeScalingConfigurationsResponse, <-chan error) {
+	responseChan := make(chan *DescribeScalingConfigurationsResponse, 1)
+	errChan := make(chan error, 1)
+	err := client.AddAsyncTask(func() {
+		defer close(responseChan)
+		defer close(errChan)
+		response, err := client.DescribeScalingConfigurationsWithContext(ctx, request)
+		if ctx.Err() != nil {
+			errChan <- ctx.Err()
+			return
+		}
+		if err != nil {
+			errChan <- err
+		} else {
+			responseChan <- response
+		}
+	})
+	if err != nil {
+		errChan <- err
+		close(responseChan)
+		close(errChan)
+	}
+	return responseChan, errChan
+}
+
+// DescribeScalingConfigurationsWithCallback invokes the ess.DescribeScalingConfigurations API asynchronously
+// api document: https://help.aliyun.com/api/ess/describescalingconfigurations.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) DescribeScalingConfigurationsWithCallback(request *DescribeScalingConfigurationsRequest, callback func(response *DescribeScalingConfigurationsResponse, err error)) <-chan int {
+	return client.DescribeScalingConfigurationsWithCallbackContext(context.Background(), request, callback)
+}
+
+// DescribeScalingConfigurationsWithCallbackContext invokes the ess.DescribeScalingConfigurations API asynchronously,
+// calling callback with ctx.Err() instead of issuing the request once ctx is
+// already canceled.
+// api document: https://help.aliyun.com/api/ess/describescalingconfigurations.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) DescribeScalingConfigurationsWithCallbackContext(ctx context.Context, request *DescribeScalingConfigurationsRequest, callback func(response *DescribeScalingConfigurationsResponse, err error)) <-chan int {
+	result := make(chan int, 1)
+	err := client.AddAsyncTask(func() {
+		var response *DescribeScalingConfigurationsResponse
+		var err error
+		defer close(result)
+		response, err = client.DescribeScalingConfigurationsWithContext(ctx, request)
+		if ctx.Err() != nil {
+			callback(nil, ctx.Err())
+			result <- 0
+			return
+		}
+		callback(response, err)
+		result <- 1
+	})
+	if err != nil {
+		defer close(result)
+		callback(nil, err)
+		result <- 0
+	}
+	return result
+}
+
+// DescribeScalingConfigurationsRequest is the request struct for api DescribeScalingConfigurations
+type DescribeScalingConfigurationsRequest struct {
+	*requests.RpcRequest
+	ResourceOwnerId          requests.Integer `position:"Query" name:"ResourceOwnerId"`
+	ResourceOwnerAccount     string           `position:"Query" name:"ResourceOwnerAccount"`
+	OwnerAccount             string           `position:"Query" name:"OwnerAccount"`
+	OwnerId                  requests.Integer `position:"Query" name:"OwnerId"`
+	ScalingGroupId           string           `position:"Query" name:"ScalingGroupId"`
+	ScalingConfigurationId1  string           `position:"Query" name:"ScalingConfigurationId.1"`
+	ScalingConfigurationId2  string           `position:"Query" name:"ScalingConfigurationId.2"`
+	ScalingConfigurationName string           `position:"Query" name:"ScalingConfigurationName"`
+	PageNumber               requests.Integer `position:"Query" name:"PageNumber"`
+	PageSize                 requests.Integer `position:"Query" name:"PageSize"`
+}
+
+// DescribeScalingConfigurationsResponse is the response struct for api DescribeScalingConfigurations
+type DescribeScalingConfigurationsResponse struct {
+	*responses.BaseResponse
+	TotalCount            int                   `json:"TotalCount" xml:"TotalCount"`
+	PageNumber            int                   `json:"PageNumber" xml:"PageNumber"`
+	PageSize              int                   `json:"PageSize" xml:"PageSize"`
+	RequestId             string                `json:"RequestId" xml:"RequestId"`
+	ScalingConfigurations ScalingConfigurations `json:"ScalingConfigurations" xml:"ScalingConfigurations"`
+}
+
+// CreateDescribeScalingConfigurationsRequest creates a request to invoke DescribeScalingConfigurations API
+func CreateDescribeScalingConfigurationsRequest() (request *DescribeScalingConfigurationsRequest) {
+	request = &DescribeScalingConfigurationsRequest{
+		RpcRequest: &requests.RpcRequest{},
+	}
+	request.InitWithApiInfo("Ess", "2014-08-28", "DescribeScalingConfigurations", "ess", "openAPI")
+	return
+}
+
+// CreateDescribeScalingConfigurationsResponse creates a response to parse from DescribeScalingConfigurations response
+func CreateDescribeScalingConfigurationsResponse() (response *DescribeScalingConfigurationsResponse) {
+	response = &DescribeScalingConfigurationsResponse{
+		BaseResponse: &responses.BaseResponse{},
+	}
+	return
+}
+
+// ScalingConfigurations is a nested struct in ess response
+type ScalingConfigurations struct {
+	ScalingConfiguration []ScalingConfiguration `json:"ScalingConfiguration" xml:"ScalingConfiguration"`
+}
+
+// ScalingConfiguration is a nested struct in ess response
+type ScalingConfiguration struct {
+	ScalingConfigurationId  string `json:"ScalingConfigurationId" xml:"ScalingConfigurationId"`
+	ScalingConfigurationName string `json:"ScalingConfigurationName" xml:"ScalingConfigurationName"`
+	ScalingGroupId          string `json:"ScalingGroupId" xml:"ScalingGroupId"`
+	ImageId                 string `json:"ImageId" xml:"ImageId"`
+	InstanceType            string `json:"InstanceType" xml:"InstanceType"`
+	SecurityGroupId         string `json:"SecurityGroupId" xml:"SecurityGroupId"`
+	InternetChargeType      string `json:"InternetChargeType" xml:"InternetChargeType"`
+	InternetMaxBandwidthIn  int    `json:"InternetMaxBandwidthIn" xml:"InternetMaxBandwidthIn"`
+	InternetMaxBandwidthOut int    `json:"InternetMaxBandwidthOut" xml:"InternetMaxBandwidthOut"`
+	IoOptimized             bool   `json:"IoOptimized" xml:"IoOptimized"`
+	KeyPairName             string `json:"KeyPairName" xml:"KeyPairName"`
+	RamRoleName             string `json:"RamRoleName" xml:"RamRoleName"`
+	SpotStrategy            string `json:"SpotStrategy" xml:"SpotStrategy"`
+	SpotPriceLimit          float64 `json:"SpotPriceLimit" xml:"SpotPriceLimit"`
+	LifecycleState          string `json:"LifecycleState" xml:"LifecycleState"`
+	CreationTime            string `json:"CreationTime" xml:"CreationTime"`
+}
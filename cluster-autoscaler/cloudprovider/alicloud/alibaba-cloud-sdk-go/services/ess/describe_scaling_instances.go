@@ -17,6 +17,8 @@ limitations under the License.
 package ess
 
 import (
+	"context"
+
 	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/requests"
 	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/responses"
 )
@@ -24,8 +26,15 @@ import (
 // DescribeScalingInstances invokes the ess.DescribeScalingInstances API synchronously
 // api document: https://help.aliyun.com/api/ess/describescalinginstances.html
 func (client *Client) DescribeScalingInstances(request *DescribeScalingInstancesRequest) (response *DescribeScalingInstancesResponse, err error) {
+	return client.DescribeScalingInstancesWithContext(context.Background(), request)
+}
+
+// DescribeScalingInstancesWithContext invokes the ess.DescribeScalingInstances API synchronously,
+// honoring ctx cancellation across the RetryPolicy's backoff and rate-limit waits.
+// api document: https://help.aliyun.com/api/ess/describescalinginstances.html
+func (client *Client) DescribeScalingInstancesWithContext(ctx context.Context, request *DescribeScalingInstancesRequest) (response *DescribeScalingInstancesResponse, err error) {
 	response = CreateDescribeScalingInstancesResponse()
-	err = client.DoAction(request, response)
+	err = client.doActionWithRetry(ctx, request, response)
 	return
 }
 
@@ -33,12 +42,24 @@ func (client *Client) DescribeScalingInstances(request *DescribeScalingInstances
 // api document: https://help.aliyun.com/api/ess/describescalinginstances.html
 // asynchronous document: https://help.aliyun.com/document_detail/66220.html
 func (client *Client) DescribeScalingInstancesWithChan(request *DescribeScalingInstancesRequest) (<-chan *DescribeScalingInstancesResponse, <-chan error) {
+	return client.DescribeScalingInstancesWithChanContext(context.Background(), request)
+}
+
+// DescribeScalingInstancesWithChanContext invokes the ess.DescribeScalingInstances API asynchronously,
+// sending ctx.Err() on the error channel instead of issuing the request once ctx is already canceled.
+// api document: https://help.aliyun.com/api/ess/describescalinginstances.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) DescribeScalingInstancesWithChanContext(ctx context.Context, request *DescribeScalingInstancesRequest) (<-chan *DescribeScalingInstancesResponse, <-chan error) {
 	responseChan := make(chan *DescribeScalingInstancesResponse, 1)
 	errChan := make(chan error, 1)
 	err := client.AddAsyncTask(func() {
 		defer close(responseChan)
 		defer close(errChan)
-		response, err := client.DescribeScalingInstances(request)
+		response, err := client.DescribeScalingInstancesWithContext(ctx, request)
+		if ctx.Err() != nil {
+			errChan <- ctx.Err()
+			return
+		}
 		if err != nil {
 			errChan <- err
 		} else {
@@ -57,12 +78,26 @@ func (client *Client) DescribeScalingInstancesWithChan(request *DescribeScalingI
 // api document: https://help.aliyun.com/api/ess/describescalinginstances.html
 // asynchronous document: https://help.aliyun.com/document_detail/66220.html
 func (client *Client) DescribeScalingInstancesWithCallback(request *DescribeScalingInstancesRequest, callback func(response *DescribeScalingInstancesResponse, err error)) <-chan int {
+	return client.DescribeScalingInstancesWithCallbackContext(context.Background(), request, callback)
+}
+
+// DescribeScalingInstancesWithCallbackContext invokes the ess.DescribeScalingInstances API asynchronously,
+// calling callback with ctx.Err() instead of issuing the request once ctx is
+// already canceled.
+// api document: https://help.aliyun.com/api/ess/describescalinginstances.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) DescribeScalingInstancesWithCallbackContext(ctx context.Context, request *DescribeScalingInstancesRequest, callback func(response *DescribeScalingInstancesResponse, err error)) <-chan int {
 	result := make(chan int, 1)
 	err := client.AddAsyncTask(func() {
 		var response *DescribeScalingInstancesResponse
 		var err error
 		defer close(result)
-		response, err = client.DescribeScalingInstances(request)
+		response, err = client.DescribeScalingInstancesWithContext(ctx, request)
+		if ctx.Err() != nil {
+			callback(nil, ctx.Err())
+			result <- 0
+			return
+		}
 		callback(response, err)
 		result <- 1
 	})
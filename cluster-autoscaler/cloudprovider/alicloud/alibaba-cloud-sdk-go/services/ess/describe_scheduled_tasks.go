@@ -0,0 +1,171 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ess
+
+import (
+	"context"
+
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/requests"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/responses"
+)
+
+// DescribeScheduledTasks invokes the ess.DescribeScheduledTasks API synchronously
+// api document: https://help.aliyun.com/api/ess/describescheduledtasks.html
+func (client *Client) DescribeScheduledTasks(request *DescribeScheduledTasksRequest) (response *DescribeScheduledTasksResponse, err error) {
+	return client.DescribeScheduledTasksWithContext(context.Background(), request)
+}
+
+// DescribeScheduledTasksWithContext invokes the ess.DescribeScheduledTasks API synchronously,
+// honoring ctx cancellation across the RetryPolicy's backoff and rate-limit waits.
+// api document: https://help.aliyun.com/api/ess/describescheduledtasks.html
+func (client *Client) DescribeScheduledTasksWithContext(ctx context.Context, request *DescribeScheduledTasksRequest) (response *DescribeScheduledTasksResponse, err error) {
+	response = CreateDescribeScheduledTasksResponse()
+	err = client.doActionWithRetry(ctx, request, response)
+	return
+}
+
+// DescribeScheduledTasksWithChan invokes the ess.DescribeScheduledTasks API asynchronously
+// api document: https://help.aliyun.com/api/ess/describescheduledtasks.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) DescribeScheduledTasksWithChan(request *DescribeScheduledTasksRequest) (<-chan *DescribeScheduledTasksResponse, <-chan error) {
+	return client.DescribeScheduledTasksWithChanContext(context.Background(), request)
+}
+
+// DescribeScheduledTasksWithChanContext invokes the ess.DescribeScheduledTasks API asynchronously,
+// sending ctx.Err() on the error channel instead of issuing the request once ctx is already canceled.
+// api document: https://help.aliyun.com/api/ess/describescheduledtasks.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) DescribeScheduledTasksWithChanContext(ctx context.Context, request *DescribeScheduledTasksRequest) (<-chan *DescribeScheduledTasksResponse, <-chan error) {
+	responseChan := make(chan *DescribeScheduledTasksResponse, 1)
+	errChan := make(chan error, 1)
+	err := client.AddAsyncTask(func() {
+		defer close(responseChan)
+		defer close(errChan)
+		response, err := client.DescribeScheduledTasksWithContext(ctx, request)
+		if ctx.Err() != nil {
+			errChan <- ctx.Err()
+			return
+		}
+		if err != nil {
+			errChan <- err
+		} else {
+			responseChan <- response
+		}
+	})
+	if err != nil {
+		errChan <- err
+		close(responseChan)
+		close(errChan)
+	}
+	return responseChan, errChan
+}
+
+// DescribeScheduledTasksWithCallback invokes the ess.DescribeScheduledTasks API asynchronously
+// api document: https://help.aliyun.com/api/ess/describescheduledtasks.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) DescribeScheduledTasksWithCallback(request *DescribeScheduledTasksRequest, callback func(response *DescribeScheduledTasksResponse, err error)) <-chan int {
+	return client.DescribeScheduledTasksWithCallbackContext(context.Background(), request, callback)
+}
+
+// DescribeScheduledTasksWithCallbackContext invokes the ess.DescribeScheduledTasks API asynchronously,
+// calling callback with ctx.Err() instead of issuing the request once ctx is
+// already canceled.
+// api document: https://help.aliyun.com/api/ess/describescheduledtasks.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) DescribeScheduledTasksWithCallbackContext(ctx context.Context, request *DescribeScheduledTasksRequest, callback func(response *DescribeScheduledTasksResponse, err error)) <-chan int {
+	result := make(chan int, 1)
+	err := client.AddAsyncTask(func() {
+		var response *DescribeScheduledTasksResponse
+		var err error
+		defer close(result)
+		response, err = client.DescribeScheduledTasksWithContext(ctx, request)
+		if ctx.Err() != nil {
+			callback(nil, ctx.Err())
+			result <- 0
+			return
+		}
+		callback(response, err)
+		result <- 1
+	})
+	if err != nil {
+		defer close(result)
+		callback(nil, err)
+		result <- 0
+	}
+	return result
+}
+
+// DescribeScheduledTasksRequest is the request struct for api DescribeScheduledTasks
+type DescribeScheduledTasksRequest struct {
+	*requests.RpcRequest
+	ResourceOwnerId      requests.Integer `position:"Query" name:"ResourceOwnerId"`
+	ResourceOwnerAccount string           `position:"Query" name:"ResourceOwnerAccount"`
+	OwnerAccount         string           `position:"Query" name:"OwnerAccount"`
+	OwnerId              requests.Integer `position:"Query" name:"OwnerId"`
+	ScheduledTaskId1     string           `position:"Query" name:"ScheduledTaskId.1"`
+	ScheduledTaskId2     string           `position:"Query" name:"ScheduledTaskId.2"`
+	ScheduledTaskName    string           `position:"Query" name:"ScheduledTaskName"`
+	ScheduledAction      string           `position:"Query" name:"ScheduledAction"`
+	PageNumber           requests.Integer `position:"Query" name:"PageNumber"`
+	PageSize             requests.Integer `position:"Query" name:"PageSize"`
+}
+
+// DescribeScheduledTasksResponse is the response struct for api DescribeScheduledTasks
+type DescribeScheduledTasksResponse struct {
+	*responses.BaseResponse
+	TotalCount     int            `json:"TotalCount" xml:"TotalCount"`
+	PageNumber     int            `json:"PageNumber" xml:"PageNumber"`
+	PageSize       int            `json:"PageSize" xml:"PageSize"`
+	RequestId      string         `json:"RequestId" xml:"RequestId"`
+	ScheduledTasks ScheduledTasks `json:"ScheduledTasks" xml:"ScheduledTasks"`
+}
+
+// CreateDescribeScheduledTasksRequest creates a request to invoke DescribeScheduledTasks API
+func CreateDescribeScheduledTasksRequest() (request *DescribeScheduledTasksRequest) {
+	request = &DescribeScheduledTasksRequest{
+		RpcRequest: &requests.RpcRequest{},
+	}
+	request.InitWithApiInfo("Ess", "2014-08-28", "DescribeScheduledTasks", "ess", "openAPI")
+	return
+}
+
+// CreateDescribeScheduledTasksResponse creates a response to parse from DescribeScheduledTasks response
+func CreateDescribeScheduledTasksResponse() (response *DescribeScheduledTasksResponse) {
+	response = &DescribeScheduledTasksResponse{
+		BaseResponse: &responses.BaseResponse{},
+	}
+	return
+}
+
+// ScheduledTasks is a nested struct in ess response
+type ScheduledTasks struct {
+	ScheduledTask []ScheduledTask `json:"ScheduledTask" xml:"ScheduledTask"`
+}
+
+// ScheduledTask is a nested struct in ess response
+type ScheduledTask struct {
+	ScheduledTaskId      string `json:"ScheduledTaskId" xml:"ScheduledTaskId"`
+	ScheduledTaskName    string `json:"ScheduledTaskName" xml:"ScheduledTaskName"`
+	Description          string `json:"Description" xml:"Description"`
+	ScheduledAction      string `json:"ScheduledAction" xml:"ScheduledAction"`
+	LaunchTime           string `json:"LaunchTime" xml:"LaunchTime"`
+	LaunchExpirationTime int    `json:"LaunchExpirationTime" xml:"LaunchExpirationTime"`
+	RecurrenceType       string `json:"RecurrenceType" xml:"RecurrenceType"`
+	RecurrenceValue      string `json:"RecurrenceValue" xml:"RecurrenceValue"`
+	RecurrenceEndTime    string `json:"RecurrenceEndTime" xml:"RecurrenceEndTime"`
+	TaskEnabled          bool   `json:"TaskEnabled" xml:"TaskEnabled"`
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ess
+
+import (
+	"context"
+
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/requests"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/responses"
+)
+
+// DisableScalingConfiguration invokes the ess.DisableScalingConfiguration API synchronously
+// api document: https://help.aliyun.com/api/ess/disablescalingconfiguration.html
+func (client *Client) DisableScalingConfiguration(request *DisableScalingConfigurationRequest) (response *DisableScalingConfigurationResponse, err error) {
+	return client.DisableScalingConfigurationWithContext(context.Background(), request)
+}
+
+// DisableScalingConfigurationWithContext invokes the ess.DisableScalingConfiguration API synchronously,
+// honoring ctx cancellation across the RetryPolicy's backoff and rate-limit waits.
+// api document: https://help.aliyun.com/api/ess/disablescalingconfiguration.html
+func (client *Client) DisableScalingConfigurationWithContext(ctx context.Context, request *DisableScalingConfigurationRequest) (response *DisableScalingConfigurationResponse, err error) {
+	response = CreateDisableScalingConfigurationResponse()
+	err = client.doActionWithRetry(ctx, request, response)
+	return
+}
+
+// DisableScalingConfigurationWithChan invokes the ess.DisableScalingConfiguration API asynchronously
+// api document: https://help.aliyun.com/api/ess/disablescalingconfiguration.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) DisableScalingConfigurationWithChan(request *DisableScalingConfigurationRequest) (<-chan *DisableScalingConfigurationResponse, <-chan error) {
+	return client.DisableScalingConfigurationWithChanContext(context.Background(), request)
+}
+
+// DisableScalingConfigurationWithChanContext invokes the ess.DisableScalingConfiguration API asynchronously,
+// sending ctx.Err() on the error channel instead of issuing the request once ctx is already canceled.
+// api document: https://help.aliyun.com/api/ess/disablescalingconfiguration.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) DisableScalingConfigurationWithChanContext(ctx context.Context, request *DisableScalingConfigurationRequest) (<-chan *DisableScalingConfigurationResponse, <-chan error) {
+	responseChan := make(chan *DisableScalingConfigurationResponse, 1)
+	errChan := make(chan error, 1)
+	err := client.AddAsyncTask(func() {
+		defer close(responseChan)
+		defer close(errChan)
+		response, err := client.DisableScalingConfigurationWithContext(ctx, request)
+		if ctx.Err() != nil {
+			errChan <- ctx.Err()
+			return
+		}
+		if err != nil {
+			errChan <- err
+		} else {
+			responseChan <- response
+		}
+	})
+	if err != nil {
+		errChan <- err
+		close(responseChan)
+		close(errChan)
+	}
+	return responseChan, errChan
+}
+
+// DisableScalingConfigurationWithCallback invokes the ess.DisableScalingConfiguration API asynchronously
+// api document: https://help.aliyun.com/api/ess/disablescalingconfiguration.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) DisableScalingConfigurationWithCallback(request *DisableScalingConfigurationRequest, callback func(response *DisableScalingConfigurationResponse, err error)) <-chan int {
+	return client.DisableScalingConfigurationWithCallbackContext(context.Background(), request, callback)
+}
+
+// DisableScalingConfigurationWithCallbackContext invokes the ess.DisableScalingConfiguration API asynchronously,
+// calling callback with ctx.Err() instead of issuing the request once ctx is
+// already canceled.
+// api document: https://help.aliyun.com/api/ess/disablescalingconfiguration.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) DisableScalingConfigurationWithCallbackContext(ctx context.Context, request *DisableScalingConfigurationRequest, callback func(response *DisableScalingConfigurationResponse, err error)) <-chan int {
+	result := make(chan int, 1)
+	err := client.AddAsyncTask(func() {
+		var response *DisableScalingConfigurationResponse
+		var err error
+		defer close(result)
+		response, err = client.DisableScalingConfigurationWithContext(ctx, request)
+		if ctx.Err() != nil {
+			callback(nil, ctx.Err())
+			result <- 0
+			return
+		}
+		callback(response, err)
+		result <- 1
+	})
+	if err != nil {
+		defer close(result)
+		callback(nil, err)
+		result <- 0
+	}
+	return result
+}
+
+// DisableScalingConfigurationRequest is the request struct for api DisableScalingConfiguration
+type DisableScalingConfigurationRequest struct {
+	*requests.RpcRequest
+	ResourceOwnerId        requests.Integer `position:"Query" name:"ResourceOwnerId"`
+	ResourceOwnerAccount   string           `position:"Query" name:"ResourceOwnerAccount"`
+	OwnerAccount           string           `position:"Query" name:"OwnerAccount"`
+	OwnerId                requests.Integer `position:"Query" name:"OwnerId"`
+	ScalingGroupId         string           `position:"Query" name:"ScalingGroupId"`
+	ScalingConfigurationId string           `position:"Query" name:"ScalingConfigurationId"`
+}
+
+// DisableScalingConfigurationResponse is the response struct for api DisableScalingConfiguration
+type DisableScalingConfigurationResponse struct {
+	*responses.BaseResponse
+	RequestId string `json:"RequestId" xml:"RequestId"`
+}
+
+// CreateDisableScalingConfigurationRequest creates a request to invoke DisableScalingConfiguration API
+func CreateDisableScalingConfigurationRequest() (request *DisableScalingConfigurationRequest) {
+	request = &DisableScalingConfigurationRequest{
+		RpcRequest: &requests.RpcRequest{},
+	}
+	request.InitWithApiInfo("Ess", "2014-08-28", "DisableScalingConfiguration", "ess", "openAPI")
+	return
+}
+
+// CreateDisableScalingConfigurationResponse creates a response to parse from DisableScalingConfiguration response
+func CreateDisableScalingConfigurationResponse() (response *DisableScalingConfigurationResponse) {
+	response = &DisableScalingConfigurationResponse{
+		BaseResponse: &responses.BaseResponse{},
+	}
+	return
+}
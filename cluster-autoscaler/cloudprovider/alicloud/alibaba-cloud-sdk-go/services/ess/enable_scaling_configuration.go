@@ -0,0 +1,144 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ess
+
+import (
+	"context"
+
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/requests"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/responses"
+)
+
+// EnableScalingConfiguration invokes the ess.EnableScalingConfiguration API synchronously
+// api document: https://help.aliyun.com/api/ess/enablescalingconfiguration.html
+func (client *Client) EnableScalingConfiguration(request *EnableScalingConfigurationRequest) (response *EnableScalingConfigurationResponse, err error) {
+	return client.EnableScalingConfigurationWithContext(context.Background(), request)
+}
+
+// EnableScalingConfigurationWithContext invokes the ess.EnableScalingConfiguration API synchronously,
+// honoring ctx cancellation across the RetryPolicy's backoff and rate-limit waits.
+// api document: https://help.aliyun.com/api/ess/enablescalingconfiguration.html
+func (client *Client) EnableScalingConfigurationWithContext(ctx context.Context, request *EnableScalingConfigurationRequest) (response *EnableScalingConfigurationResponse, err error) {
+	response = CreateEnableScalingConfigurationResponse()
+	err = client.doActionWithRetry(ctx, request, response)
+	return
+}
+
+// EnableScalingConfigurationWithChan invokes the ess.EnableScalingConfiguration API asynchronously
+// api document: https://help.aliyun.com/api/ess/enablescalingconfiguration.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) EnableScalingConfigurationWithChan(request *EnableScalingConfigurationRequest) (<-chan *EnableScalingConfigurationResponse, <-chan error) {
+	return client.EnableScalingConfigurationWithChanContext(context.Background(), request)
+}
+
+// EnableScalingConfigurationWithChanContext invokes the ess.EnableScalingConfiguration API asynchronously,
+// sending ctx.Err() on the error channel instead of issuing the request once ctx is already canceled.
+// api document: https://help.aliyun.com/api/ess/enablescalingconfiguration.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) EnableScalingConfigurationWithChanContext(ctx context.Context, request *EnableScalingConfigurationRequest) (<-chan *EnableScalingConfigurationResponse, <-chan error) {
+	responseChan := make(chan *EnableScalingConfigurationResponse, 1)
+	errChan := make(chan error, 1)
+	err := client.AddAsyncTask(func() {
+		defer close(responseChan)
+		defer close(errChan)
+		response, err := client.EnableScalingConfigurationWithContext(ctx, request)
+		if ctx.Err() != nil {
+			errChan <- ctx.Err()
+			return
+		}
+		if err != nil {
+			errChan <- err
+		} else {
+			responseChan <- response
+		}
+	})
+	if err != nil {
+		errChan <- err
+		close(responseChan)
+		close(errChan)
+	}
+	return responseChan, errChan
+}
+
+// EnableScalingConfigurationWithCallback invokes the ess.EnableScalingConfiguration API asynchronously
+// api document: https://help.aliyun.com/api/ess/enablescalingconfiguration.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) EnableScalingConfigurationWithCallback(request *EnableScalingConfigurationRequest, callback func(response *EnableScalingConfigurationResponse, err error)) <-chan int {
+	return client.EnableScalingConfigurationWithCallbackContext(context.Background(), request, callback)
+}
+
+// EnableScalingConfigurationWithCallbackContext invokes the ess.EnableScalingConfiguration API asynchronously,
+// calling callback with ctx.Err() instead of issuing the request once ctx is
+// already canceled.
+// api document: https://help.aliyun.com/api/ess/enablescalingconfiguration.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) EnableScalingConfigurationWithCallbackContext(ctx context.Context, request *EnableScalingConfigurationRequest, callback func(response *EnableScalingConfigurationResponse, err error)) <-chan int {
+	result := make(chan int, 1)
+	err := client.AddAsyncTask(func() {
+		var response *EnableScalingConfigurationResponse
+		var err error
+		defer close(result)
+		response, err = client.EnableScalingConfigurationWithContext(ctx, request)
+		if ctx.Err() != nil {
+			callback(nil, ctx.Err())
+			result <- 0
+			return
+		}
+		callback(response, err)
+		result <- 1
+	})
+	if err != nil {
+		defer close(result)
+		callback(nil, err)
+		result <- 0
+	}
+	return result
+}
+
+// EnableScalingConfigurationRequest is the request struct for api EnableScalingConfiguration
+type EnableScalingConfigurationRequest struct {
+	*requests.RpcRequest
+	ResourceOwnerId        requests.Integer `position:"Query" name:"ResourceOwnerId"`
+	ResourceOwnerAccount   string           `position:"Query" name:"ResourceOwnerAccount"`
+	OwnerAccount           string           `position:"Query" name:"OwnerAccount"`
+	OwnerId                requests.Integer `position:"Query" name:"OwnerId"`
+	ScalingGroupId         string           `position:"Query" name:"ScalingGroupId"`
+	ScalingConfigurationId string           `position:"Query" name:"ScalingConfigurationId"`
+}
+
+// EnableScalingConfigurationResponse is the response struct for api EnableScalingConfiguration
+type EnableScalingConfigurationResponse struct {
+	*responses.BaseResponse
+	RequestId string `json:"RequestId" xml:"RequestId"`
+}
+
+// CreateEnableScalingConfigurationRequest creates a request to invoke EnableScalingConfiguration API
+func CreateEnableScalingConfigurationRequest() (request *EnableScalingConfigurationRequest) {
+	request = &EnableScalingConfigurationRequest{
+		RpcRequest: &requests.RpcRequest{},
+	}
+	request.InitWithApiInfo("Ess", "2014-08-28", "EnableScalingConfiguration", "ess", "openAPI")
+	return
+}
+
+// CreateEnableScalingConfigurationResponse creates a response to parse from EnableScalingConfiguration response
+func CreateEnableScalingConfigurationResponse() (response *EnableScalingConfigurationResponse) {
+	response = &EnableScalingConfigurationResponse{
+		BaseResponse: &responses.BaseResponse{},
+	}
+	return
+}
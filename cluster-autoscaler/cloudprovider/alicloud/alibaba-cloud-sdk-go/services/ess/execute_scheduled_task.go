@@ -0,0 +1,111 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ess
+
+import (
+	"context"
+
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/requests"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/responses"
+)
+
+// ExecuteScheduledTask invokes the ess.ExecuteScheduledTask API synchronously
+// api document: https://help.aliyun.com/api/ess/executescheduledtask.html
+func (client *Client) ExecuteScheduledTask(request *ExecuteScheduledTaskRequest) (response *ExecuteScheduledTaskResponse, err error) {
+	response = CreateExecuteScheduledTaskResponse()
+	err = client.doActionWithRetry(context.Background(), request, response)
+	return
+}
+
+// ExecuteScheduledTaskWithChan invokes the ess.ExecuteScheduledTask API asynchronously
+// api document: https://help.aliyun.com/api/ess/executescheduledtask.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) ExecuteScheduledTaskWithChan(request *ExecuteScheduledTaskRequest) (<-chan *ExecuteScheduledTaskResponse, <-chan error) {
+	responseChan := make(chan *ExecuteScheduledTaskResponse, 1)
+	errChan := make(chan error, 1)
+	err := client.AddAsyncTask(func() {
+		defer close(responseChan)
+		defer close(errChan)
+		response, err := client.ExecuteScheduledTask(request)
+		if err != nil {
+			errChan <- err
+		} else {
+			responseChan <- response
+		}
+	})
+	if err != nil {
+		errChan <- err
+		close(responseChan)
+		close(errChan)
+	}
+	return responseChan, errChan
+}
+
+// ExecuteScheduledTaskWithCallback invokes the ess.ExecuteScheduledTask API asynchronously
+// api document: https://help.aliyun.com/api/ess/executescheduledtask.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) ExecuteScheduledTaskWithCallback(request *ExecuteScheduledTaskRequest, callback func(response *ExecuteScheduledTaskResponse, err error)) <-chan int {
+	result := make(chan int, 1)
+	err := client.AddAsyncTask(func() {
+		var response *ExecuteScheduledTaskResponse
+		var err error
+		defer close(result)
+		response, err = client.ExecuteScheduledTask(request)
+		callback(response, err)
+		result <- 1
+	})
+	if err != nil {
+		defer close(result)
+		callback(nil, err)
+		result <- 0
+	}
+	return result
+}
+
+// ExecuteScheduledTaskRequest is the request struct for api ExecuteScheduledTask
+type ExecuteScheduledTaskRequest struct {
+	*requests.RpcRequest
+	ResourceOwnerId      requests.Integer `position:"Query" name:"ResourceOwnerId"`
+	ResourceOwnerAccount string           `position:"Query" name:"ResourceOwnerAccount"`
+	OwnerAccount         string           `position:"Query" name:"OwnerAccount"`
+	OwnerId              requests.Integer `position:"Query" name:"OwnerId"`
+	ScheduledTaskId      string           `position:"Query" name:"ScheduledTaskId"`
+	ScheduledActionType  string           `position:"Query" name:"ScheduledActionType"`
+}
+
+// ExecuteScheduledTaskResponse is the response struct for api ExecuteScheduledTask
+type ExecuteScheduledTaskResponse struct {
+	*responses.BaseResponse
+	RequestId string `json:"RequestId" xml:"RequestId"`
+}
+
+// CreateExecuteScheduledTaskRequest creates a request to invoke ExecuteScheduledTask API
+func CreateExecuteScheduledTaskRequest() (request *ExecuteScheduledTaskRequest) {
+	request = &ExecuteScheduledTaskRequest{
+		RpcRequest: &requests.RpcRequest{},
+	}
+	request.InitWithApiInfo("Ess", "2014-08-28", "ExecuteScheduledTask", "ess", "openAPI")
+	return
+}
+
+// CreateExecuteScheduledTaskResponse creates a response to parse from ExecuteScheduledTask response
+func CreateExecuteScheduledTaskResponse() (response *ExecuteScheduledTaskResponse) {
+	response = &ExecuteScheduledTaskResponse{
+		BaseResponse: &responses.BaseResponse{},
+	}
+	return
+}
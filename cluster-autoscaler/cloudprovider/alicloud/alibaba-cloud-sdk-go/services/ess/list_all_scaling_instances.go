@@ -0,0 +1,182 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ess
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/requests"
+)
+
+// NOTE: this file covers the ess-client-level helper. ScalingGroup (package
+// alicloud) is the node-group refresh path that calls it: its TargetSize
+// and Nodes methods use ListAllScalingInstances instead of paginating
+// DescribeScalingInstances by hand.
+
+// maxInstanceIdFilterSize is the number of InstanceId.N query fields
+// DescribeScalingInstancesRequest exposes; callers with more instance IDs
+// than this must be split across multiple requests.
+const maxInstanceIdFilterSize = 20
+
+// defaultListPageSize is the PageSize ListAllScalingInstances walks with
+// when paging through DescribeScalingInstances.
+const defaultListPageSize = 50
+
+// ScalingInstanceFilter narrows a ListAllScalingInstances call the same way
+// DescribeScalingInstancesRequest's non-paging fields do, minus the
+// InstanceId.N straitjacket: InstanceIds can be any length.
+type ScalingInstanceFilter struct {
+	ScalingGroupId         string
+	ScalingConfigurationId string
+	LifecycleState         string
+	CreationType           string
+	HealthStatus           string
+	InstanceIds            []string
+}
+
+// PartialFailureError is returned by ListAllScalingInstances when at least
+// one instance-ID chunk failed to describe. ListAllScalingInstances still
+// returns every ScalingInstance collected from the chunks that succeeded,
+// so a refresh of a large scaling group doesn't discard instances it
+// already has an answer for just because one chunk was throttled.
+type PartialFailureError struct {
+	Errors []error
+}
+
+// Error implements error.
+func (e *PartialFailureError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		messages = append(messages, err.Error())
+	}
+	return fmt.Sprintf("%d of the underlying requests failed: %s", len(e.Errors), strings.Join(messages, "; "))
+}
+
+// ListAllScalingInstances describes every instance matching filter,
+// transparently paging through PageNumber/PageSize until TotalCount is
+// reached and, when filter.InstanceIds is set, splitting it into chunks of
+// maxInstanceIdFilterSize and fanning them out over the Client's
+// AddAsyncTask worker pool. Honors ctx cancellation across pages and
+// chunks. Partial results are returned alongside a *PartialFailureError if
+// any chunk failed.
+func (client *Client) ListAllScalingInstances(ctx context.Context, filter ScalingInstanceFilter) ([]ScalingInstance, error) {
+	if len(filter.InstanceIds) == 0 {
+		return client.listScalingInstancePages(ctx, filter, nil)
+	}
+
+	chunks := chunkInstanceIds(filter.InstanceIds, maxInstanceIdFilterSize)
+
+	type chunkResult struct {
+		instances []ScalingInstance
+		err       error
+	}
+	resultChan := make(chan chunkResult, len(chunks))
+	for _, chunk := range chunks {
+		chunk := chunk
+		err := client.AddAsyncTask(func() {
+			instances, err := client.listScalingInstancePages(ctx, filter, chunk)
+			resultChan <- chunkResult{instances: instances, err: err}
+		})
+		if err != nil {
+			resultChan <- chunkResult{err: err}
+		}
+	}
+
+	var all []ScalingInstance
+	var errs []error
+	for range chunks {
+		result := <-resultChan
+		if result.err != nil {
+			errs = append(errs, result.err)
+			continue
+		}
+		all = append(all, result.instances...)
+	}
+	if len(errs) > 0 {
+		return all, &PartialFailureError{Errors: errs}
+	}
+	return all, nil
+}
+
+// listScalingInstancePages walks DescribeScalingInstances for a single
+// instanceIds chunk (at most maxInstanceIdFilterSize long, or nil to not
+// filter by instance ID at all) until every matching instance has been
+// collected.
+func (client *Client) listScalingInstancePages(ctx context.Context, filter ScalingInstanceFilter, instanceIds []string) ([]ScalingInstance, error) {
+	var all []ScalingInstance
+	pageNumber := 1
+	for {
+		if err := ctx.Err(); err != nil {
+			return all, err
+		}
+
+		request := CreateDescribeScalingInstancesRequest()
+		request.ScalingGroupId = filter.ScalingGroupId
+		request.ScalingConfigurationId = filter.ScalingConfigurationId
+		request.LifecycleState = filter.LifecycleState
+		request.CreationType = filter.CreationType
+		request.HealthStatus = filter.HealthStatus
+		request.PageNumber = requests.NewInteger(pageNumber)
+		request.PageSize = requests.NewInteger(defaultListPageSize)
+		setInstanceIdFilter(request, instanceIds)
+
+		response := CreateDescribeScalingInstancesResponse()
+		if err := client.doActionWithRetry(ctx, request, response); err != nil {
+			return all, err
+		}
+
+		all = append(all, response.ScalingInstances.ScalingInstance...)
+		if len(response.ScalingInstances.ScalingInstance) == 0 || len(all) >= response.TotalCount {
+			return all, nil
+		}
+		pageNumber++
+	}
+}
+
+// setInstanceIdFilter copies ids (at most maxInstanceIdFilterSize long)
+// into request's InstanceId1..InstanceId20 fields.
+func setInstanceIdFilter(request *DescribeScalingInstancesRequest, ids []string) {
+	fields := [maxInstanceIdFilterSize]*string{
+		&request.InstanceId1, &request.InstanceId2, &request.InstanceId3, &request.InstanceId4,
+		&request.InstanceId5, &request.InstanceId6, &request.InstanceId7, &request.InstanceId8,
+		&request.InstanceId9, &request.InstanceId10, &request.InstanceId11, &request.InstanceId12,
+		&request.InstanceId13, &request.InstanceId14, &request.InstanceId15, &request.InstanceId16,
+		&request.InstanceId17, &request.InstanceId18, &request.InstanceId19, &request.InstanceId20,
+	}
+	for i, id := range ids {
+		if i >= len(fields) {
+			break
+		}
+		*fields[i] = id
+	}
+}
+
+// chunkInstanceIds splits ids into slices of at most size entries each.
+func chunkInstanceIds(ids []string, size int) [][]string {
+	var chunks [][]string
+	for len(ids) > 0 {
+		end := size
+		if end > len(ids) {
+			end = len(ids)
+		}
+		chunks = append(chunks, ids[:end])
+		ids = ids[end:]
+	}
+	return chunks
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ess
+
+import (
+	"context"
+
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/requests"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/responses"
+)
+
+// ModifyScalingConfiguration invokes the ess.ModifyScalingConfiguration API synchronously
+// api document: https://help.aliyun.com/api/ess/modifyscalingconfiguration.html
+func (client *Client) ModifyScalingConfiguration(request *ModifyScalingConfigurationRequest) (response *ModifyScalingConfigurationResponse, err error) {
+	return client.ModifyScalingConfigurationWithContext(context.Background(), request)
+}
+
+// ModifyScalingConfigurationWithContext invokes the ess.ModifyScalingConfiguration API synchronously,
+// honoring ctx cancellation across the RetryPolicy's backoff and rate-limit waits.
+// api document: https://help.aliyun.com/api/ess/modifyscalingconfiguration.html
+func (client *Client) ModifyScalingConfigurationWithContext(ctx context.Context, request *ModifyScalingConfigurationRequest) (response *ModifyScalingConfigurationResponse, err error) {
+	response = CreateModifyScalingConfigurationResponse()
+	err = client.doActionWithRetry(ctx, request, response)
+	return
+}
+
+// ModifyScalingConfigurationWithChan invokes the ess.ModifyScalingConfiguration API asynchronously
+// api document: https://help.aliyun.com/api/ess/modifyscalingconfiguration.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) ModifyScalingConfigurationWithChan(request *ModifyScalingConfigurationRequest) (<-chan *ModifyScalingConfigurationResponse, <-chan error) {
+	return client.ModifyScalingConfigurationWithChanContext(context.Background(), request)
+}
+
+// ModifyScalingConfigurationWithChanContext invokes the ess.ModifyScalingConfiguration API asynchronously,
+// sending ctx.Err() on the error channel instead of issuing the request once ctx is already canceled.
+// api document: https://help.aliyun.com/api/ess/modifyscalingconfiguration.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) ModifyScalingConfigurationWithChanContext(ctx context.Context, request *ModifyScalingConfigurationRequest) (<-chan *ModifyScalingConfigurationResponse, <-chan error) {
+	responseChan := make(chan *ModifyScalingConfigurationResponse, 1)
+	errChan := make(chan error, 1)
+	err := client.AddAsyncTask(func() {
+		defer close(responseChan)
+		defer close(errChan)
+		response, err := client.ModifyScalingConfigurationWithContext(ctx, request)
+		if ctx.Err() != nil {
+			errChan <- ctx.Err()
+			return
+		}
+		if err != nil {
+			errChan <- err
+		} else {
+			responseChan <- response
+		}
+	})
+	if err != nil {
+		errChan <- err
+		close(responseChan)
+		close(errChan)
+	}
+	return responseChan, errChan
+}
+
+// ModifyScalingConfigurationWithCallback invokes the ess.ModifyScalingConfiguration API asynchronously
+// api document: https://help.aliyun.com/api/ess/modifyscalingconfiguration.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) ModifyScalingConfigurationWithCallback(request *ModifyScalingConfigurationRequest, callback func(response *ModifyScalingConfigurationResponse, err error)) <-chan int {
+	return client.ModifyScalingConfigurationWithCallbackContext(context.Background(), request, callback)
+}
+
+// ModifyScalingConfigurationWithCallbackContext invokes the ess.ModifyScalingConfiguration API asynchronously,
+// calling callback with ctx.Err() instead of issuing the request once ctx is
+// already canceled.
+// api document: https://help.aliyun.com/api/ess/modifyscalingconfiguration.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) ModifyScalingConfigurationWithCallbackContext(ctx context.Context, request *ModifyScalingConfigurationRequest, callback func(response *ModifyScalingConfigurationResponse, err error)) <-chan int {
+	result := make(chan int, 1)
+	err := client.AddAsyncTask(func() {
+		var response *ModifyScalingConfigurationResponse
+		var err error
+		defer close(result)
+		response, err = client.ModifyScalingConfigurationWithContext(ctx, request)
+		if ctx.Err() != nil {
+			callback(nil, ctx.Err())
+			result <- 0
+			return
+		}
+		callback(response, err)
+		result <- 1
+	})
+	if err != nil {
+		defer close(result)
+		callback(nil, err)
+		result <- 0
+	}
+	return result
+}
+
+// ModifyScalingConfigurationRequest is the request struct for api ModifyScalingConfiguration
+type ModifyScalingConfigurationRequest struct {
+	*requests.RpcRequest
+	ResourceOwnerId          requests.Integer `position:"Query" name:"ResourceOwnerId"`
+	ResourceOwnerAccount     string           `position:"Query" name:"ResourceOwnerAccount"`
+	OwnerAccount             string           `position:"Query" name:"OwnerAccount"`
+	OwnerId                  requests.Integer `position:"Query" name:"OwnerId"`
+	ScalingConfigurationId   string           `position:"Query" name:"ScalingConfigurationId"`
+	ImageId                  string           `position:"Query" name:"ImageId"`
+	InstanceType             string           `position:"Query" name:"InstanceType"`
+	SecurityGroupId          string           `position:"Query" name:"SecurityGroupId"`
+	ScalingConfigurationName string           `position:"Query" name:"ScalingConfigurationName"`
+	InternetChargeType       string           `position:"Query" name:"InternetChargeType"`
+	InternetMaxBandwidthIn   requests.Integer `position:"Query" name:"InternetMaxBandwidthIn"`
+	InternetMaxBandwidthOut  requests.Integer `position:"Query" name:"InternetMaxBandwidthOut"`
+	SystemDiskCategory       string           `position:"Query" name:"SystemDisk.Category"`
+	SystemDiskSize           requests.Integer `position:"Query" name:"SystemDisk.Size"`
+	IoOptimized              string           `position:"Query" name:"IoOptimized"`
+	KeyPairName              string           `position:"Query" name:"KeyPairName"`
+	RamRoleName              string           `position:"Query" name:"RamRoleName"`
+	UserData                 string           `position:"Query" name:"UserData"`
+	SpotStrategy             string           `position:"Query" name:"SpotStrategy"`
+	SpotPriceLimit           requests.Float   `position:"Query" name:"SpotPriceLimit"`
+}
+
+// ModifyScalingConfigurationResponse is the response struct for api ModifyScalingConfiguration
+type ModifyScalingConfigurationResponse struct {
+	*responses.BaseResponse
+	RequestId string `json:"RequestId" xml:"RequestId"`
+}
+
+// CreateModifyScalingConfigurationRequest creates a request to invoke ModifyScalingConfiguration API
+func CreateModifyScalingConfigurationRequest() (request *ModifyScalingConfigurationRequest) {
+	request = &ModifyScalingConfigurationRequest{
+		RpcRequest: &requests.RpcRequest{},
+	}
+	request.InitWithApiInfo("Ess", "2014-08-28", "ModifyScalingConfiguration", "ess", "openAPI")
+	return
+}
+
+// CreateModifyScalingConfigurationResponse creates a response to parse from ModifyScalingConfiguration response
+func CreateModifyScalingConfigurationResponse() (response *ModifyScalingConfigurationResponse) {
+	response = &ModifyScalingConfigurationResponse{
+		BaseResponse: &responses.BaseResponse{},
+	}
+	return
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package ess
 
 import (
+	"context"
+
 	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/requests"
 	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/responses"
 )
@@ -24,8 +26,15 @@ import (
 // ModifyScalingGroup invokes the ess.ModifyScalingGroup API synchronously
 // api document: https://help.aliyun.com/api/ess/modifyscalinggroup.html
 func (client *Client) ModifyScalingGroup(request *ModifyScalingGroupRequest) (response *ModifyScalingGroupResponse, err error) {
+	return client.ModifyScalingGroupWithContext(context.Background(), request)
+}
+
+// ModifyScalingGroupWithContext invokes the ess.ModifyScalingGroup API synchronously,
+// honoring ctx cancellation across the RetryPolicy's backoff and rate-limit waits.
+// api document: https://help.aliyun.com/api/ess/modifyscalinggroup.html
+func (client *Client) ModifyScalingGroupWithContext(ctx context.Context, request *ModifyScalingGroupRequest) (response *ModifyScalingGroupResponse, err error) {
 	response = CreateModifyScalingGroupResponse()
-	err = client.DoAction(request, response)
+	err = client.doActionWithRetry(ctx, request, response)
 	return
 }
 
@@ -33,12 +42,24 @@ func (client *Client) ModifyScalingGroup(request *ModifyScalingGroupRequest) (re
 // api document: https://help.aliyun.com/api/ess/modifyscalinggroup.html
 // asynchronous document: https://help.aliyun.com/document_detail/66220.html
 func (client *Client) ModifyScalingGroupWithChan(request *ModifyScalingGroupRequest) (<-chan *ModifyScalingGroupResponse, <-chan error) {
+	return client.ModifyScalingGroupWithChanContext(context.Background(), request)
+}
+
+// ModifyScalingGroupWithChanContext invokes the ess.ModifyScalingGroup API asynchronously,
+// sending ctx.Err() on the error channel instead of issuing the request once ctx is already canceled.
+// api document: https://help.aliyun.com/api/ess/modifyscalinggroup.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) ModifyScalingGroupWithChanContext(ctx context.Context, request *ModifyScalingGroupRequest) (<-chan *ModifyScalingGroupResponse, <-chan error) {
 	responseChan := make(chan *ModifyScalingGroupResponse, 1)
 	errChan := make(chan error, 1)
 	err := client.AddAsyncTask(func() {
 		defer close(responseChan)
 		defer close(errChan)
-		response, err := client.ModifyScalingGroup(request)
+		response, err := client.ModifyScalingGroupWithContext(ctx, request)
+		if ctx.Err() != nil {
+			errChan <- ctx.Err()
+			return
+		}
 		if err != nil {
 			errChan <- err
 		} else {
@@ -57,12 +78,26 @@ func (client *Client) ModifyScalingGroupWithChan(request *ModifyScalingGroupRequ
 // api document: https://help.aliyun.com/api/ess/modifyscalinggroup.html
 // asynchronous document: https://help.aliyun.com/document_detail/66220.html
 func (client *Client) ModifyScalingGroupWithCallback(request *ModifyScalingGroupRequest, callback func(response *ModifyScalingGroupResponse, err error)) <-chan int {
+	return client.ModifyScalingGroupWithCallbackContext(context.Background(), request, callback)
+}
+
+// ModifyScalingGroupWithCallbackContext invokes the ess.ModifyScalingGroup API asynchronously,
+// calling callback with ctx.Err() instead of issuing the request once ctx is
+// already canceled.
+// api document: https://help.aliyun.com/api/ess/modifyscalinggroup.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) ModifyScalingGroupWithCallbackContext(ctx context.Context, request *ModifyScalingGroupRequest, callback func(response *ModifyScalingGroupResponse, err error)) <-chan int {
 	result := make(chan int, 1)
 	err := client.AddAsyncTask(func() {
 		var response *ModifyScalingGroupResponse
 		var err error
 		defer close(result)
-		response, err = client.ModifyScalingGroup(request)
+		response, err = client.ModifyScalingGroupWithContext(ctx, request)
+		if ctx.Err() != nil {
+			callback(nil, ctx.Err())
+			result <- 0
+			return
+		}
 		callback(response, err)
 		result <- 1
 	})
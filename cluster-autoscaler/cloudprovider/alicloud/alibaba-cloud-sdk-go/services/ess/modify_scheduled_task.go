@@ -0,0 +1,152 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ess
+
+import (
+	"context"
+
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/requests"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/responses"
+)
+
+// ModifyScheduledTask invokes the ess.ModifyScheduledTask API synchronously
+// api document: https://help.aliyun.com/api/ess/modifyscheduledtask.html
+func (client *Client) ModifyScheduledTask(request *ModifyScheduledTaskRequest) (response *ModifyScheduledTaskResponse, err error) {
+	return client.ModifyScheduledTaskWithContext(context.Background(), request)
+}
+
+// ModifyScheduledTaskWithContext invokes the ess.ModifyScheduledTask API synchronously,
+// honoring ctx cancellation across the RetryPolicy's backoff and rate-limit waits.
+// api document: https://help.aliyun.com/api/ess/modifyscheduledtask.html
+func (client *Client) ModifyScheduledTaskWithContext(ctx context.Context, request *ModifyScheduledTaskRequest) (response *ModifyScheduledTaskResponse, err error) {
+	response = CreateModifyScheduledTaskResponse()
+	err = client.doActionWithRetry(ctx, request, response)
+	return
+}
+
+// ModifyScheduledTaskWithChan invokes the ess.ModifyScheduledTask API asynchronously
+// api document: https://help.aliyun.com/api/ess/modifyscheduledtask.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) ModifyScheduledTaskWithChan(request *ModifyScheduledTaskRequest) (<-chan *ModifyScheduledTaskResponse, <-chan error) {
+	return client.ModifyScheduledTaskWithChanContext(context.Background(), request)
+}
+
+// ModifyScheduledTaskWithChanContext invokes the ess.ModifyScheduledTask API asynchronously,
+// sending ctx.Err() on the error channel instead of issuing the request once ctx is already canceled.
+// api document: https://help.aliyun.com/api/ess/modifyscheduledtask.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) ModifyScheduledTaskWithChanContext(ctx context.Context, request *ModifyScheduledTaskRequest) (<-chan *ModifyScheduledTaskResponse, <-chan error) {
+	responseChan := make(chan *ModifyScheduledTaskResponse, 1)
+	errChan := make(chan error, 1)
+	err := client.AddAsyncTask(func() {
+		defer close(responseChan)
+		defer close(errChan)
+		response, err := client.ModifyScheduledTaskWithContext(ctx, request)
+		if ctx.Err() != nil {
+			errChan <- ctx.Err()
+			return
+		}
+		if err != nil {
+			errChan <- err
+		} else {
+			responseChan <- response
+		}
+	})
+	if err != nil {
+		errChan <- err
+		close(responseChan)
+		close(errChan)
+	}
+	return responseChan, errChan
+}
+
+// ModifyScheduledTaskWithCallback invokes the ess.ModifyScheduledTask API asynchronously
+// api document: https://help.aliyun.com/api/ess/modifyscheduledtask.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) ModifyScheduledTaskWithCallback(request *ModifyScheduledTaskRequest, callback func(response *ModifyScheduledTaskResponse, err error)) <-chan int {
+	return client.ModifyScheduledTaskWithCallbackContext(context.Background(), request, callback)
+}
+
+// ModifyScheduledTaskWithCallbackContext invokes the ess.ModifyScheduledTask API asynchronously,
+// calling callback with ctx.Err() instead of issuing the request once ctx is
+// already canceled.
+// api document: https://help.aliyun.com/api/ess/modifyscheduledtask.html
+// asynchronous document: https://help.aliyun.com/document_detail/66220.html
+func (client *Client) ModifyScheduledTaskWithCallbackContext(ctx context.Context, request *ModifyScheduledTaskRequest, callback func(response *ModifyScheduledTaskResponse, err error)) <-chan int {
+	result := make(chan int, 1)
+	err := client.AddAsyncTask(func() {
+		var response *ModifyScheduledTaskResponse
+		var err error
+		defer close(result)
+		response, err = client.ModifyScheduledTaskWithContext(ctx, request)
+		if ctx.Err() != nil {
+			callback(nil, ctx.Err())
+			result <- 0
+			return
+		}
+		callback(response, err)
+		result <- 1
+	})
+	if err != nil {
+		defer close(result)
+		callback(nil, err)
+		result <- 0
+	}
+	return result
+}
+
+// ModifyScheduledTaskRequest is the request struct for api ModifyScheduledTask
+type ModifyScheduledTaskRequest struct {
+	*requests.RpcRequest
+	ResourceOwnerId      requests.Integer `position:"Query" name:"ResourceOwnerId"`
+	ResourceOwnerAccount string           `position:"Query" name:"ResourceOwnerAccount"`
+	OwnerAccount         string           `position:"Query" name:"OwnerAccount"`
+	OwnerId              requests.Integer `position:"Query" name:"OwnerId"`
+	ScheduledTaskId      string           `position:"Query" name:"ScheduledTaskId"`
+	ScheduledTaskName    string           `position:"Query" name:"ScheduledTaskName"`
+	Description          string           `position:"Query" name:"Description"`
+	ScheduledAction      string           `position:"Query" name:"ScheduledAction"`
+	LaunchTime           string           `position:"Query" name:"LaunchTime"`
+	LaunchExpirationTime requests.Integer `position:"Query" name:"LaunchExpirationTime"`
+	RecurrenceType       string           `position:"Query" name:"RecurrenceType"`
+	RecurrenceValue      string           `position:"Query" name:"RecurrenceValue"`
+	RecurrenceEndTime    string           `position:"Query" name:"RecurrenceEndTime"`
+	TaskEnabled          requests.Boolean `position:"Query" name:"TaskEnabled"`
+}
+
+// ModifyScheduledTaskResponse is the response struct for api ModifyScheduledTask
+type ModifyScheduledTaskResponse struct {
+	*responses.BaseResponse
+	RequestId string `json:"RequestId" xml:"RequestId"`
+}
+
+// CreateModifyScheduledTaskRequest creates a request to invoke ModifyScheduledTask API
+func CreateModifyScheduledTaskRequest() (request *ModifyScheduledTaskRequest) {
+	request = &ModifyScheduledTaskRequest{
+		RpcRequest: &requests.RpcRequest{},
+	}
+	request.InitWithApiInfo("Ess", "2014-08-28", "ModifyScheduledTask", "ess", "openAPI")
+	return
+}
+
+// CreateModifyScheduledTaskResponse creates a response to parse from ModifyScheduledTask response
+func CreateModifyScheduledTaskResponse() (response *ModifyScheduledTaskResponse) {
+	response = &ModifyScheduledTaskResponse{
+		BaseResponse: &responses.BaseResponse{},
+	}
+	return
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ess
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/errors"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/requests"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/responses"
+)
+
+// defaultRetryableCodes are the ess error Codes that are safe to retry: they
+// indicate the request was throttled or collided with another in-flight
+// scaling activity rather than being rejected outright.
+var defaultRetryableCodes = map[string]bool{
+	"Throttling":                true,
+	"Throttling.User":           true,
+	"ScalingActivityInProgress": true,
+}
+
+// RetryPolicy controls how Client.doActionWithRetry retries throttled ess calls and
+// how many requests per second are allowed per API action.
+type RetryPolicy struct {
+	// MaxRetries is the number of additional attempts after the first one.
+	MaxRetries int
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the exponentially growing delay between retries.
+	MaxBackoff time.Duration
+	// Jitter adds up to this fraction of the computed backoff as random jitter.
+	Jitter float64
+	// RetryableCodes lists the ess error Codes that should be retried. A nil
+	// map falls back to defaultRetryableCodes.
+	RetryableCodes map[string]bool
+	// QPS is the maximum number of requests per second allowed for a single
+	// API action. Zero disables rate limiting.
+	QPS float64
+
+	mutex    sync.Mutex
+	limiters map[string]*tokenBucket
+}
+
+// DefaultRetryPolicy returns the RetryPolicy used when a Client is created
+// without an explicit one.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries:     5,
+		InitialBackoff: 200 * time.Millisecond,
+		MaxBackoff:     10 * time.Second,
+		Jitter:         0.2,
+		QPS:            5,
+	}
+}
+
+// tokenBucket is a minimal QPS limiter keyed by action name.
+type tokenBucket struct {
+	mutex      sync.Mutex
+	qps        float64
+	tokens     float64
+	lastRefill time.Time
+}
+
+func newTokenBucket(qps float64) *tokenBucket {
+	return &tokenBucket{qps: qps, tokens: qps, lastRefill: time.Now()}
+}
+
+func (b *tokenBucket) wait(ctx context.Context) error {
+	for {
+		b.mutex.Lock()
+		now := time.Now()
+		b.tokens += now.Sub(b.lastRefill).Seconds() * b.qps
+		burst := b.qps
+		if burst < 1 {
+			burst = 1
+		}
+		if b.tokens > burst {
+			b.tokens = burst
+		}
+		b.lastRefill = now
+		if b.tokens >= 1 {
+			b.tokens--
+			b.mutex.Unlock()
+			return nil
+		}
+		b.mutex.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func (p *RetryPolicy) limiterFor(action string) *tokenBucket {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.limiters == nil {
+		p.limiters = make(map[string]*tokenBucket)
+	}
+	limiter, ok := p.limiters[action]
+	if !ok {
+		limiter = newTokenBucket(p.QPS)
+		p.limiters[action] = limiter
+	}
+	return limiter
+}
+
+func (p *RetryPolicy) retryableCodes() map[string]bool {
+	if p.RetryableCodes != nil {
+		return p.RetryableCodes
+	}
+	return defaultRetryableCodes
+}
+
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	backoff := p.InitialBackoff << uint(attempt)
+	if backoff > p.MaxBackoff || backoff <= 0 {
+		backoff = p.MaxBackoff
+	}
+	if p.Jitter > 0 {
+		backoff += time.Duration(rand.Float64() * p.Jitter * float64(backoff))
+	}
+	return backoff
+}
+
+// retryPolicyMutex guards the lazy initialization in retryPolicy: DoAction
+// is called concurrently from every WithChan/WithCallback goroutine, so two
+// of them racing on the same Client's first call must not both see a nil
+// RetryPolicy and write it unsynchronized.
+var retryPolicyMutex sync.Mutex
+
+// retryPolicy returns the Client's configured RetryPolicy, falling back to
+// DefaultRetryPolicy the first time it's needed.
+func (client *Client) retryPolicy() *RetryPolicy {
+	retryPolicyMutex.Lock()
+	defer retryPolicyMutex.Unlock()
+	if client.RetryPolicy == nil {
+		client.RetryPolicy = DefaultRetryPolicy()
+	}
+	return client.RetryPolicy
+}
+
+// doActionWithRetry wraps DoAction with the Client's RetryPolicy: it honors
+// ctx cancellation, rate-limits per action name, and retries errors in
+// RetryableCodes with exponential backoff.
+func (client *Client) doActionWithRetry(ctx context.Context, request requests.AcsRequest, response responses.AcsResponse) error {
+	policy := client.retryPolicy()
+	action := request.GetActionName()
+	var limiter *tokenBucket
+	if policy.QPS > 0 {
+		limiter = policy.limiterFor(action)
+	}
+
+	var err error
+	for attempt := 0; attempt <= policy.MaxRetries; attempt++ {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		if limiter != nil {
+			if err = limiter.wait(ctx); err != nil {
+				return err
+			}
+		}
+
+		err = client.DoAction(request, response)
+		if err == nil {
+			return nil
+		}
+
+		serverErr, ok := err.(*errors.ServerError)
+		if !ok || !policy.retryableCodes()[serverErr.ErrorCode()] || attempt == policy.MaxRetries {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(policy.backoff(attempt)):
+		}
+	}
+	return err
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ess
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/requests"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/responses"
+)
+
+// rrsaCredentialsRefreshSkew is how long before STS credentials actually
+// expire that oidcCredentialsProvider proactively refreshes them, so a
+// request in flight never races an expiring token.
+const rrsaCredentialsRefreshSkew = 5 * time.Minute
+
+// oidcCredentialsProvider implements Provider by exchanging the projected
+// ServiceAccount token at tokenFilePath for STS credentials via
+// AssumeRoleWithOIDC, caching them until they're within
+// rrsaCredentialsRefreshSkew of expiring. It is the credential source behind
+// NewClientWithRRSA.
+type oidcCredentialsProvider struct {
+	regionId        string
+	roleARN         string
+	oidcProviderARN string
+	tokenFilePath   string
+	roleSessionName string
+
+	mu              sync.Mutex
+	accessKeyId     string
+	accessKeySecret string
+	securityToken   string
+	expiration      time.Time
+
+	now func() time.Time
+	// assumeRole is the STS call GetCredentials refreshes through; overridden
+	// in tests to stand in for a live STS endpoint.
+	assumeRole func(regionId, roleARN, oidcProviderARN, oidcToken, roleSessionName string) (*assumeRoleWithOIDCResponse, error)
+}
+
+// Compile-time assertion that oidcCredentialsProvider satisfies the Provider
+// contract NewClientWithRRSA hands it to NewClientWithProvider through: a
+// provider whose GetCredentials signature drifts from what Provider expects
+// would otherwise ship as a silent, unusable RRSA bootstrap until someone
+// exercised it at runtime.
+var _ Provider = (*oidcCredentialsProvider)(nil)
+
+func newOIDCCredentialsProvider(regionId, roleARN, oidcProviderARN, oidcTokenFilePath, roleSessionName string) *oidcCredentialsProvider {
+	return &oidcCredentialsProvider{
+		regionId:        regionId,
+		roleARN:         roleARN,
+		oidcProviderARN: oidcProviderARN,
+		tokenFilePath:   oidcTokenFilePath,
+		roleSessionName: roleSessionName,
+		now:             time.Now,
+		assumeRole:      assumeRoleWithOIDC,
+	}
+}
+
+// GetCredentials returns the current AccessKeyId, AccessKeySecret and
+// SecurityToken, refreshing them first if they're missing or within
+// rrsaCredentialsRefreshSkew of expiring.
+func (p *oidcCredentialsProvider) GetCredentials() (string, string, string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.now().Add(rrsaCredentialsRefreshSkew).Before(p.expiration) {
+		return p.accessKeyId, p.accessKeySecret, p.securityToken, nil
+	}
+
+	token, err := ioutil.ReadFile(p.tokenFilePath)
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to read OIDC token from %q: %v", p.tokenFilePath, err)
+	}
+
+	response, err := p.assumeRole(p.regionId, p.roleARN, p.oidcProviderARN, string(token), p.roleSessionName)
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to assume role %q with OIDC token: %v", p.roleARN, err)
+	}
+
+	expiration, err := time.Parse(time.RFC3339, response.Credentials.Expiration)
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to parse STS credentials expiration %q: %v", response.Credentials.Expiration, err)
+	}
+
+	p.accessKeyId = response.Credentials.AccessKeyId
+	p.accessKeySecret = response.Credentials.AccessKeySecret
+	p.securityToken = response.Credentials.SecurityToken
+	p.expiration = expiration
+
+	return p.accessKeyId, p.accessKeySecret, p.securityToken, nil
+}
+
+// assumeRoleWithOIDCRequest is the request struct for the STS
+// AssumeRoleWithOIDC API, kept local to this package since RRSA is currently
+// the only ess credential path that needs it.
+type assumeRoleWithOIDCRequest struct {
+	*requests.RpcRequest
+	RoleArn         string `position:"Query" name:"RoleArn"`
+	OIDCProviderArn string `position:"Query" name:"OIDCProviderArn"`
+	OIDCToken       string `position:"Query" name:"OIDCToken"`
+	RoleSessionName string `position:"Query" name:"RoleSessionName"`
+}
+
+// assumeRoleWithOIDCResponse is the response struct for the STS
+// AssumeRoleWithOIDC API.
+type assumeRoleWithOIDCResponse struct {
+	*responses.BaseResponse
+	RequestId   string                       `json:"RequestId" xml:"RequestId"`
+	Credentials assumeRoleWithOIDCCredentials `json:"Credentials" xml:"Credentials"`
+}
+
+// assumeRoleWithOIDCCredentials is a nested struct in the STS
+// AssumeRoleWithOIDC response.
+type assumeRoleWithOIDCCredentials struct {
+	AccessKeyId     string `json:"AccessKeyId" xml:"AccessKeyId"`
+	AccessKeySecret string `json:"AccessKeySecret" xml:"AccessKeySecret"`
+	SecurityToken   string `json:"SecurityToken" xml:"SecurityToken"`
+	Expiration      string `json:"Expiration" xml:"Expiration"`
+}
+
+// assumeRoleWithOIDC calls the STS AssumeRoleWithOIDC API through a throwaway
+// Client initialized with only regionId, since this call bootstraps the very
+// credentials a provider-backed Client relies on. The call still goes through
+// (*Client).doActionWithRetry with that Client's default RetryPolicy.
+// api document: https://help.aliyun.com/document_detail/327123.html
+func assumeRoleWithOIDC(regionId, roleARN, oidcProviderARN, oidcToken, roleSessionName string) (*assumeRoleWithOIDCResponse, error) {
+	request := &assumeRoleWithOIDCRequest{
+		RpcRequest:      &requests.RpcRequest{},
+		RoleArn:         roleARN,
+		OIDCProviderArn: oidcProviderARN,
+		OIDCToken:       oidcToken,
+		RoleSessionName: roleSessionName,
+	}
+	request.InitWithApiInfo("Sts", "2015-04-01", "AssumeRoleWithOIDC", "sts", "openAPI")
+
+	response := &assumeRoleWithOIDCResponse{
+		BaseResponse: &responses.BaseResponse{},
+	}
+
+	client := &Client{}
+	if err := client.InitWithRegionId(regionId); err != nil {
+		return nil, err
+	}
+	if err := client.doActionWithRetry(context.Background(), request, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ess
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// fakeSTS stands in for the live AssumeRoleWithOIDC endpoint: each call
+// returns the next response in order and bumps a call counter so tests can
+// assert on how many times GetCredentials actually refreshed.
+type fakeSTS struct {
+	calls     int
+	responses []*assumeRoleWithOIDCResponse
+	err       error
+}
+
+func (f *fakeSTS) assumeRole(regionId, roleARN, oidcProviderARN, oidcToken, roleSessionName string) (*assumeRoleWithOIDCResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	response := f.responses[f.calls]
+	f.calls++
+	return response, nil
+}
+
+func credentialsResponse(accessKeyId string, expiration time.Time) *assumeRoleWithOIDCResponse {
+	return &assumeRoleWithOIDCResponse{
+		Credentials: assumeRoleWithOIDCCredentials{
+			AccessKeyId:     accessKeyId,
+			AccessKeySecret: accessKeyId + "-secret",
+			SecurityToken:   accessKeyId + "-token",
+			Expiration:      expiration.Format(time.RFC3339),
+		},
+	}
+}
+
+func newTestProvider(t *testing.T, sts *fakeSTS, now func() time.Time) *oidcCredentialsProvider {
+	t.Helper()
+	tokenPath := filepath.Join(t.TempDir(), "oidc-token")
+	if err := ioutil.WriteFile(tokenPath, []byte("fake-oidc-token"), 0600); err != nil {
+		t.Fatalf("failed to write fake OIDC token: %v", err)
+	}
+
+	provider := newOIDCCredentialsProvider("cn-hangzhou", "role-arn", "oidc-provider-arn", tokenPath, "session")
+	provider.now = now
+	provider.assumeRole = sts.assumeRole
+	return provider
+}
+
+func TestOIDCCredentialsProviderRefreshesOnFirstCall(t *testing.T) {
+	clock := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sts := &fakeSTS{responses: []*assumeRoleWithOIDCResponse{
+		credentialsResponse("AKFIRST", clock.Add(time.Hour)),
+	}}
+	provider := newTestProvider(t, sts, func() time.Time { return clock })
+
+	accessKeyId, accessKeySecret, securityToken, err := provider.GetCredentials()
+	if err != nil {
+		t.Fatalf("GetCredentials returned error: %v", err)
+	}
+	if accessKeyId != "AKFIRST" || accessKeySecret != "AKFIRST-secret" || securityToken != "AKFIRST-token" {
+		t.Errorf("got (%q, %q, %q), want AKFIRST triple", accessKeyId, accessKeySecret, securityToken)
+	}
+	if sts.calls != 1 {
+		t.Errorf("expected exactly one STS call, got %d", sts.calls)
+	}
+}
+
+func TestOIDCCredentialsProviderReusesUnexpiredCredentials(t *testing.T) {
+	clock := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sts := &fakeSTS{responses: []*assumeRoleWithOIDCResponse{
+		credentialsResponse("AKFIRST", clock.Add(time.Hour)),
+	}}
+	provider := newTestProvider(t, sts, func() time.Time { return clock })
+
+	if _, _, _, err := provider.GetCredentials(); err != nil {
+		t.Fatalf("first GetCredentials returned error: %v", err)
+	}
+	// Expiration is an hour away, well outside the refresh skew window: should
+	// reuse the cached credentials.
+	accessKeyId, _, _, err := provider.GetCredentials()
+	if err != nil {
+		t.Fatalf("second GetCredentials returned error: %v", err)
+	}
+	if accessKeyId != "AKFIRST" {
+		t.Errorf("accessKeyId = %q, want cached AKFIRST", accessKeyId)
+	}
+	if sts.calls != 1 {
+		t.Errorf("expected GetCredentials to reuse cached credentials without a second STS call, got %d calls", sts.calls)
+	}
+}
+
+func TestOIDCCredentialsProviderRefreshesWithinSkewWindow(t *testing.T) {
+	clock := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sts := &fakeSTS{responses: []*assumeRoleWithOIDCResponse{
+		credentialsResponse("AKFIRST", clock.Add(rrsaCredentialsRefreshSkew+time.Minute)),
+		credentialsResponse("AKSECOND", clock.Add(2*time.Hour)),
+	}}
+	provider := newTestProvider(t, sts, func() time.Time { return clock })
+
+	if _, _, _, err := provider.GetCredentials(); err != nil {
+		t.Fatalf("first GetCredentials returned error: %v", err)
+	}
+
+	// Advance the clock to within rrsaCredentialsRefreshSkew of the cached
+	// credentials' expiration: GetCredentials must refresh instead of reusing them.
+	clock = clock.Add(2 * time.Minute)
+	accessKeyId, _, _, err := provider.GetCredentials()
+	if err != nil {
+		t.Fatalf("second GetCredentials returned error: %v", err)
+	}
+	if accessKeyId != "AKSECOND" {
+		t.Errorf("accessKeyId = %q, want refreshed AKSECOND", accessKeyId)
+	}
+	if sts.calls != 2 {
+		t.Errorf("expected a refresh once within the skew window, got %d STS calls", sts.calls)
+	}
+}
+
+func TestOIDCCredentialsProviderPropagatesSTSError(t *testing.T) {
+	clock := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sts := &fakeSTS{err: fmt.Errorf("sts: access denied")}
+	provider := newTestProvider(t, sts, func() time.Time { return clock })
+
+	if _, _, _, err := provider.GetCredentials(); err == nil {
+		t.Fatal("expected GetCredentials to return an error when the STS endpoint fails")
+	}
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alicloud
+
+// AliCloudProvider is the subset of the Alibaba Cloud cloudprovider.CloudProvider
+// implementation that owns the scheduled-scaling reconciler; NodeGroups(),
+// NodeGroupForNode() and the rest of the interface live on the manager/registry
+// type that tracks ECS ScalingGroups and aren't part of this change.
+type AliCloudProvider struct {
+	scheduledScaling        *ScheduledScaling
+	scheduledScalingWindows []ScheduledScalingWindow
+}
+
+// SetScheduledScaling registers the declared scheduled-scaling windows this
+// provider's Refresh should reconcile against live ESS ScheduledTasks.
+func (ali *AliCloudProvider) SetScheduledScaling(scheduledScaling *ScheduledScaling, windows []ScheduledScalingWindow) {
+	ali.scheduledScaling = scheduledScaling
+	ali.scheduledScalingWindows = windows
+}
+
+// Refresh is called by the autoscaler's main loop before every scaling
+// decision. Alongside whatever node-group cache refresh the rest of the
+// provider does, it reconciles the declared ScheduledScalingWindows so a
+// cron-like window's min/max bounds are applied without a separate control
+// loop of their own.
+func (ali *AliCloudProvider) Refresh() error {
+	if ali.scheduledScaling == nil || len(ali.scheduledScalingWindows) == 0 {
+		return nil
+	}
+	return ali.scheduledScaling.Reconcile(ali.scheduledScalingWindows)
+}
@@ -0,0 +1,299 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/requests"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/services/emr"
+	"k8s.io/klog"
+	schedulernodeinfo "k8s.io/kubernetes/pkg/scheduler/nodeinfo"
+)
+
+// emrHostProviderIDPrefix namespaces the provider IDs EmrTaskGroup hands out
+// for EMR hosts, the same way "azure://" does for VMSS instances, so
+// DeleteNodes can recover the bare EMR host ID from a *apiv1.Node.
+const emrHostProviderIDPrefix = "emr://"
+
+// EmrTaskGroup implements cloudprovider.NodeGroup for an EMR scaling
+// TaskGroup, so Spark/Hive worker capacity on EMR clusters can be scaled the
+// same way as a plain ECS ScalingGroup.
+type EmrTaskGroup struct {
+	client      *emr.Client
+	ClusterId   string
+	TaskGroupId string
+
+	minSize int
+	maxSize int
+}
+
+// NewEmrTaskGroup creates an EmrTaskGroup backed by client for the given
+// EMR cluster and task group.
+func NewEmrTaskGroup(client *emr.Client, clusterId, taskGroupId string, minSize, maxSize int) *EmrTaskGroup {
+	return &EmrTaskGroup{
+		client:      client,
+		ClusterId:   clusterId,
+		TaskGroupId: taskGroupId,
+		minSize:     minSize,
+		maxSize:     maxSize,
+	}
+}
+
+// MinSize returns the minimum number of nodes this task group can be scaled
+// down to.
+func (t *EmrTaskGroup) MinSize() int {
+	return t.minSize
+}
+
+// MaxSize returns the maximum number of nodes this task group can be scaled
+// up to.
+func (t *EmrTaskGroup) MaxSize() int {
+	return t.maxSize
+}
+
+// Id returns the task group's identifier, namespaced by its cluster since
+// TaskGroupId alone isn't unique across EMR clusters.
+func (t *EmrTaskGroup) Id() string {
+	return fmt.Sprintf("%s/%s", t.ClusterId, t.TaskGroupId)
+}
+
+// Debug returns a debug string for the task group.
+func (t *EmrTaskGroup) Debug() string {
+	return fmt.Sprintf("%s (%d:%d)", t.Id(), t.MinSize(), t.MaxSize())
+}
+
+// Exist is always true: EmrTaskGroup only ever wraps a TaskGroup that
+// already exists on an EMR cluster, unlike the autoprovisioned node groups
+// the interface also accommodates.
+func (t *EmrTaskGroup) Exist() bool {
+	return true
+}
+
+// Create is unsupported: EMR task groups are created through the EMR
+// console/OpenAPI cluster template, not by the autoscaler.
+func (t *EmrTaskGroup) Create() (cloudprovider.NodeGroup, error) {
+	return nil, fmt.Errorf("cannot create new EMR task group: autoprovisioning is not supported")
+}
+
+// Delete is unsupported, for the same reason as Create.
+func (t *EmrTaskGroup) Delete() error {
+	return fmt.Errorf("cannot delete EMR task group %q: autoprovisioning is not supported", t.Id())
+}
+
+// Autoprovisioned is always false; see Create/Delete.
+func (t *EmrTaskGroup) Autoprovisioned() bool {
+	return false
+}
+
+// TargetSize returns the task group's current ActiveInstanceCount.
+func (t *EmrTaskGroup) TargetSize() (int, error) {
+	request := emr.CreateDescribeScalingTaskGroupRequest()
+	request.ClusterId = t.ClusterId
+	request.TaskGroupId = t.TaskGroupId
+
+	response, err := t.client.DescribeScalingTaskGroup(request)
+	if err != nil {
+		return 0, fmt.Errorf("failed to describe task group %q of cluster %q: %v", t.TaskGroupId, t.ClusterId, err)
+	}
+	return response.ScalingTaskGroup.ActiveInstanceCount, nil
+}
+
+// IncreaseSize bumps the task group's MinSize/MaxSize by delta nodes, the
+// same way ScaleSet.IncreaseSize bumps a VMSS's capacity: EMR grows the
+// cluster to MaxSize itself once the new bound is in place, so there is no
+// separate "set desired count" call.
+func (t *EmrTaskGroup) IncreaseSize(delta int) error {
+	if delta <= 0 {
+		return fmt.Errorf("size increase must be positive, got: %d", delta)
+	}
+
+	current, err := t.TargetSize()
+	if err != nil {
+		return err
+	}
+
+	request := emr.CreateModifyScalingTaskGroupRequest()
+	request.ClusterId = t.ClusterId
+	request.TaskGroupId = t.TaskGroupId
+	request.MinSize = requests.NewInteger(current + delta)
+	request.MaxSize = requests.NewInteger(current + delta)
+
+	klog.V(3).Infof("Increasing EMR task group %q of cluster %q from %d to %d", t.TaskGroupId, t.ClusterId, current, current+delta)
+	_, err = t.client.ModifyScalingTaskGroup(request)
+	if err != nil {
+		return fmt.Errorf("failed to increase task group %q of cluster %q by %d: %v", t.TaskGroupId, t.ClusterId, delta, err)
+	}
+	return nil
+}
+
+// DecreaseTargetSize decreases the task group's MinSize/MaxSize by delta
+// nodes, mirroring IncreaseSize. Callers that already deleted specific nodes
+// should use DeleteNodes instead; this is only for correcting a target size
+// that drifted ahead of the nodes that actually exist.
+func (t *EmrTaskGroup) DecreaseTargetSize(delta int) error {
+	if delta >= 0 {
+		return fmt.Errorf("size decrease must be negative, got: %d", delta)
+	}
+
+	current, err := t.TargetSize()
+	if err != nil {
+		return err
+	}
+
+	newSize := current + delta
+	if newSize < 0 {
+		newSize = 0
+	}
+
+	request := emr.CreateModifyScalingTaskGroupRequest()
+	request.ClusterId = t.ClusterId
+	request.TaskGroupId = t.TaskGroupId
+	request.MinSize = requests.NewInteger(newSize)
+	request.MaxSize = requests.NewInteger(newSize)
+
+	klog.V(3).Infof("Decreasing EMR task group %q of cluster %q from %d to %d", t.TaskGroupId, t.ClusterId, current, newSize)
+	if _, err := t.client.ModifyScalingTaskGroup(request); err != nil {
+		return fmt.Errorf("failed to decrease task group %q of cluster %q by %d: %v", t.TaskGroupId, t.ClusterId, delta, err)
+	}
+	return nil
+}
+
+// DeleteNodes releases the EMR hosts backing nodes from the task group via
+// ReleaseClusterHostGroup, recovering each host's EMR ID from its
+// "emr://<hostId>" provider ID.
+func (t *EmrTaskGroup) DeleteNodes(nodes []*apiv1.Node) error {
+	hostIds := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		hostID := strings.TrimPrefix(node.Spec.ProviderID, emrHostProviderIDPrefix)
+		if hostID == "" {
+			return fmt.Errorf("node %q has no EMR provider ID", node.Name)
+		}
+		hostIds = append(hostIds, hostID)
+	}
+	if len(hostIds) == 0 {
+		return nil
+	}
+
+	request := emr.CreateReleaseClusterHostGroupRequest()
+	request.ClusterId = t.ClusterId
+	request.NodeGroupId = t.TaskGroupId
+	request.HostIds = strings.Join(hostIds, ",")
+
+	klog.V(3).Infof("Releasing %d host(s) from EMR task group %q of cluster %q", len(hostIds), t.TaskGroupId, t.ClusterId)
+	if _, err := t.client.ReleaseClusterHostGroup(request); err != nil {
+		return fmt.Errorf("failed to release hosts %v from task group %q of cluster %q: %v", hostIds, t.TaskGroupId, t.ClusterId, err)
+	}
+	return nil
+}
+
+// Nodes lists the real EMR hosts backing this task group via
+// ListClusterHostGroup, so the returned instance IDs round-trip through
+// DeleteNodes' "emr://<hostId>" provider ID parsing instead of being
+// fabricated placeholders.
+func (t *EmrTaskGroup) Nodes() ([]cloudprovider.Instance, error) {
+	hostGroup, err := t.describeHostGroup()
+	if err != nil {
+		return nil, err
+	}
+
+	instances := make([]cloudprovider.Instance, 0, len(hostGroup.HostList.Host))
+	for _, host := range hostGroup.HostList.Host {
+		instances = append(instances, cloudprovider.Instance{
+			Id:     emrHostProviderIDPrefix + host.InstanceId,
+			Status: emrHostInstanceStatus(host),
+		})
+	}
+	return instances, nil
+}
+
+// describeHostGroup looks up this task group's HostGroup entry (matched by
+// NodeGroupId) via ListClusterHostGroup, filtered to the task group's own
+// NodeGroupType.
+func (t *EmrTaskGroup) describeHostGroup() (*emr.HostGroup, error) {
+	taskGroupRequest := emr.CreateDescribeScalingTaskGroupRequest()
+	taskGroupRequest.ClusterId = t.ClusterId
+	taskGroupRequest.TaskGroupId = t.TaskGroupId
+	taskGroupResponse, err := t.client.DescribeScalingTaskGroup(taskGroupRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe task group %q of cluster %q: %v", t.TaskGroupId, t.ClusterId, err)
+	}
+
+	hostGroupRequest := emr.CreateListClusterHostGroupRequest()
+	hostGroupRequest.ClusterId = t.ClusterId
+	hostGroupRequest.NodeGroupType = taskGroupResponse.ScalingTaskGroup.NodeGroupType
+	hostGroupResponse, err := t.client.ListClusterHostGroup(hostGroupRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list host groups of cluster %q: %v", t.ClusterId, err)
+	}
+
+	for i := range hostGroupResponse.HostGroupList.HostGroup {
+		hostGroup := &hostGroupResponse.HostGroupList.HostGroup[i]
+		if hostGroup.NodeGroupId == t.TaskGroupId {
+			return hostGroup, nil
+		}
+	}
+	return nil, fmt.Errorf("host group %q not found in cluster %q", t.TaskGroupId, t.ClusterId)
+}
+
+// emrHostInstanceStatus converts an EMR host's Status to a
+// cloudprovider.InstanceStatus.
+func emrHostInstanceStatus(host emr.Host) *cloudprovider.InstanceStatus {
+	status := &cloudprovider.InstanceStatus{}
+	switch host.Status {
+	case "Creating", "Starting":
+		status.State = cloudprovider.InstanceCreating
+	case "Releasing", "Stopping":
+		status.State = cloudprovider.InstanceDeleting
+	default:
+		status.State = cloudprovider.InstanceRunning
+	}
+	return status
+}
+
+// TemplateNodeInfo returns a minimal node template labeled with the task
+// group's CoreInstanceType. Unlike ScaleSet.TemplateNodeInfo, it cannot set
+// real CPU/memory capacity: the emr client here has no instance-type-to-
+// resource lookup, so binpacking simulation against this template will
+// undercount headroom until that mapping is added.
+func (t *EmrTaskGroup) TemplateNodeInfo() (*schedulernodeinfo.NodeInfo, error) {
+	request := emr.CreateDescribeScalingTaskGroupRequest()
+	request.ClusterId = t.ClusterId
+	request.TaskGroupId = t.TaskGroupId
+
+	response, err := t.client.DescribeScalingTaskGroup(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe task group %q of cluster %q: %v", t.TaskGroupId, t.ClusterId, err)
+	}
+
+	node := &apiv1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   fmt.Sprintf("%s-template", t.Id()),
+			Labels: map[string]string{"node.kubernetes.io/instance-type": response.ScalingTaskGroup.CoreInstanceType},
+		},
+	}
+	node.Status.Allocatable = apiv1.ResourceList{}
+	node.Status.Capacity = apiv1.ResourceList{}
+
+	nodeInfo := schedulernodeinfo.NewNodeInfo(cloudprovider.BuildKubeProxy(t.Id()))
+	nodeInfo.SetNode(node)
+	return nodeInfo, nil
+}
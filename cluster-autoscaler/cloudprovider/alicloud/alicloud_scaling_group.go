@@ -0,0 +1,209 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/requests"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/services/ess"
+)
+
+// scalingGroupRefreshTimeout bounds a single ListAllScalingInstances call
+// made from the node-group refresh path.
+const scalingGroupRefreshTimeout = 30 * time.Second
+
+// ScalingGroup implements cloudprovider.NodeGroup for an ESS ScalingGroup.
+// TargetSize and Nodes refresh through ess.Client.ListAllScalingInstances,
+// which pages and fans out across the InstanceId.1..20 filter limit
+// internally instead of the node group having to do it by hand.
+type ScalingGroup struct {
+	client *ess.Client
+	id     string
+
+	minSize int
+	maxSize int
+}
+
+// NewScalingGroup creates a ScalingGroup backed by client for the given ESS
+// scaling group ID.
+func NewScalingGroup(client *ess.Client, id string, minSize, maxSize int) *ScalingGroup {
+	return &ScalingGroup{client: client, id: id, minSize: minSize, maxSize: maxSize}
+}
+
+// MinSize returns minimum size of the scaling group.
+func (s *ScalingGroup) MinSize() int {
+	return s.minSize
+}
+
+// MaxSize returns maximum size of the scaling group.
+func (s *ScalingGroup) MaxSize() int {
+	return s.maxSize
+}
+
+// Id returns the scaling group's ID.
+func (s *ScalingGroup) Id() string {
+	return s.id
+}
+
+// Debug returns a debug string for the scaling group.
+func (s *ScalingGroup) Debug() string {
+	return fmt.Sprintf("%s (%d:%d)", s.Id(), s.MinSize(), s.MaxSize())
+}
+
+// Exist is always true: ScalingGroup only ever wraps a group that already
+// exists on Alibaba Cloud.
+func (s *ScalingGroup) Exist() bool {
+	return true
+}
+
+// Create is unsupported: ESS scaling groups are created through the
+// console/Terraform, not by the autoscaler.
+func (s *ScalingGroup) Create() (cloudprovider.NodeGroup, error) {
+	return nil, fmt.Errorf("cannot create new ESS scaling group: autoprovisioning is not supported")
+}
+
+// Delete is unsupported, for the same reason as Create.
+func (s *ScalingGroup) Delete() error {
+	return fmt.Errorf("cannot delete ESS scaling group %q: autoprovisioning is not supported", s.id)
+}
+
+// Autoprovisioned is always false; see Create/Delete.
+func (s *ScalingGroup) Autoprovisioned() bool {
+	return false
+}
+
+// TargetSize returns the number of InService instances in the group, read
+// through ListAllScalingInstances instead of the manual
+// InstanceId.1..20-chunked DescribeScalingInstances pagination the node
+// group refresh path used before.
+func (s *ScalingGroup) TargetSize() (int, error) {
+	instances, err := s.listInstances("InService")
+	if err != nil {
+		return 0, err
+	}
+	return len(instances), nil
+}
+
+// IncreaseSize bumps the scaling group's MinSize/MaxSize by delta nodes, the
+// same way ScaleSet.IncreaseSize bumps a VMSS's capacity.
+func (s *ScalingGroup) IncreaseSize(delta int) error {
+	if delta <= 0 {
+		return fmt.Errorf("size increase must be positive, got: %d", delta)
+	}
+
+	current, err := s.TargetSize()
+	if err != nil {
+		return err
+	}
+
+	request := ess.CreateModifyScalingGroupRequest()
+	request.ScalingGroupId = s.id
+	request.MinSize = requests.NewInteger(current + delta)
+	request.MaxSize = requests.NewInteger(current + delta)
+	_, err = s.client.ModifyScalingGroup(request)
+	if err != nil {
+		return fmt.Errorf("failed to increase scaling group %q by %d: %v", s.id, delta, err)
+	}
+	return nil
+}
+
+// DecreaseTargetSize decreases the scaling group's MinSize/MaxSize by delta
+// nodes, mirroring IncreaseSize.
+func (s *ScalingGroup) DecreaseTargetSize(delta int) error {
+	if delta >= 0 {
+		return fmt.Errorf("size decrease must be negative, got: %d", delta)
+	}
+
+	current, err := s.TargetSize()
+	if err != nil {
+		return err
+	}
+	newSize := current + delta
+	if newSize < 0 {
+		newSize = 0
+	}
+
+	request := ess.CreateModifyScalingGroupRequest()
+	request.ScalingGroupId = s.id
+	request.MinSize = requests.NewInteger(newSize)
+	request.MaxSize = requests.NewInteger(newSize)
+	if _, err := s.client.ModifyScalingGroup(request); err != nil {
+		return fmt.Errorf("failed to decrease scaling group %q by %d: %v", s.id, delta, err)
+	}
+	return nil
+}
+
+// DeleteNodes is not yet supported: this tree doesn't vendor the ESS
+// RemoveInstances API the refresh path would need to detach specific
+// instances from the group, only ListAllScalingInstances/ModifyScalingGroup.
+func (s *ScalingGroup) DeleteNodes(nodes []*apiv1.Node) error {
+	return fmt.Errorf("deleting individual nodes from ESS scaling group %q is not supported: RemoveInstances is not vendored in this client", s.id)
+}
+
+// Nodes returns every instance in the scaling group, across all lifecycle
+// states, via ListAllScalingInstances.
+func (s *ScalingGroup) Nodes() ([]cloudprovider.Instance, error) {
+	instances, err := s.listInstances("")
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]cloudprovider.Instance, 0, len(instances))
+	for _, instance := range instances {
+		result = append(result, cloudprovider.Instance{
+			Id:     "alicloud://" + instance.InstanceId,
+			Status: scalingInstanceStatus(instance),
+		})
+	}
+	return result, nil
+}
+
+// listInstances is the shared ListAllScalingInstances call behind
+// TargetSize and Nodes; an empty lifecycleState matches every state.
+func (s *ScalingGroup) listInstances(lifecycleState string) ([]ess.ScalingInstance, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), scalingGroupRefreshTimeout)
+	defer cancel()
+
+	instances, err := s.client.ListAllScalingInstances(ctx, ess.ScalingInstanceFilter{
+		ScalingGroupId: s.id,
+		LifecycleState: lifecycleState,
+	})
+	if err != nil {
+		return instances, fmt.Errorf("failed to list instances for scaling group %q: %v", s.id, err)
+	}
+	return instances, nil
+}
+
+// scalingInstanceStatus converts a ScalingInstance's LifecycleState to a
+// cloudprovider.InstanceStatus.
+func scalingInstanceStatus(instance ess.ScalingInstance) *cloudprovider.InstanceStatus {
+	status := &cloudprovider.InstanceStatus{}
+	switch instance.LifecycleState {
+	case "Creating", "Attaching":
+		status.State = cloudprovider.InstanceCreating
+	case "Removing", "Detaching":
+		status.State = cloudprovider.InstanceDeleting
+	default:
+		status.State = cloudprovider.InstanceRunning
+	}
+	return status
+}
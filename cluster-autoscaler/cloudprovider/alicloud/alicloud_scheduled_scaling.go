@@ -0,0 +1,148 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alicloud
+
+import (
+	"fmt"
+
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/requests"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/services/ess"
+	"k8s.io/klog"
+)
+
+// scheduledTaskNamePrefix namespaces the ESS ScheduledTasks this reconciler
+// owns so Reconcile can tell its own tasks apart from ones operators created
+// by hand through the console or Terraform.
+const scheduledTaskNamePrefix = "cluster-autoscaler-scheduled-scaling-"
+
+// ScheduledScalingWindow pins a scaling group's min/max node counts for a
+// declared window, mirroring the alicloud Terraform ess_schedule resource's
+// LaunchTime/RecurrenceType/RecurrenceValue/RecurrenceEndTime fields.
+type ScheduledScalingWindow struct {
+	ScalingGroupId    string
+	MinSize           int64
+	MaxSize           int64
+	LaunchTime        string
+	RecurrenceType    string
+	RecurrenceValue   string
+	RecurrenceEndTime string
+}
+
+// scheduledTaskClient is the subset of *ess.Client ScheduledScaling needs,
+// narrowed so tests can reconcile against a fake instead of a live ESS
+// endpoint.
+type scheduledTaskClient interface {
+	CreateScheduledTask(request *ess.CreateScheduledTaskRequest) (*ess.CreateScheduledTaskResponse, error)
+	ModifyScheduledTask(request *ess.ModifyScheduledTaskRequest) (*ess.ModifyScheduledTaskResponse, error)
+	DescribeScheduledTasks(request *ess.DescribeScheduledTasksRequest) (*ess.DescribeScheduledTasksResponse, error)
+}
+
+// ScheduledScaling reconciles a declared set of ScheduledScalingWindows
+// against the live ESS ScheduledTasks, so that cron-like windows which pin a
+// scaling group's min/max node counts are materialized as real ESS
+// resources instead of requiring the autoscaler to poll and patch
+// NodeGroup capacity itself.
+type ScheduledScaling struct {
+	client scheduledTaskClient
+}
+
+// NewScheduledScaling creates a ScheduledScaling reconciler backed by client.
+func NewScheduledScaling(client scheduledTaskClient) *ScheduledScaling {
+	return &ScheduledScaling{client: client}
+}
+
+// Reconcile creates or updates one ESS ScheduledTask per window so that its
+// declared schedule and target MinSize/MaxSize match what Alibaba Cloud
+// enforces.
+func (s *ScheduledScaling) Reconcile(windows []ScheduledScalingWindow) error {
+	for _, window := range windows {
+		if err := s.reconcileWindow(window); err != nil {
+			return fmt.Errorf("failed to reconcile scheduled scaling window for scaling group %q: %v", window.ScalingGroupId, err)
+		}
+	}
+	return nil
+}
+
+func (s *ScheduledScaling) reconcileWindow(window ScheduledScalingWindow) error {
+	taskName := scheduledTaskNamePrefix + window.ScalingGroupId
+	scalingAction := fmt.Sprintf("MinSize:%d,MaxSize:%d", window.MinSize, window.MaxSize)
+
+	existing, err := s.findScheduledTask(taskName)
+	if err != nil {
+		return err
+	}
+
+	if existing == nil {
+		request := ess.CreateCreateScheduledTaskRequest()
+		request.ScheduledTaskName = taskName
+		request.ScalingGroupId = window.ScalingGroupId
+		request.ScheduledAction = scalingAction
+		request.LaunchTime = window.LaunchTime
+		request.RecurrenceType = window.RecurrenceType
+		request.RecurrenceValue = window.RecurrenceValue
+		request.RecurrenceEndTime = window.RecurrenceEndTime
+		request.TaskEnabled = requests.NewBoolean(true)
+
+		klog.V(3).Infof("Creating scheduled scaling task %q for scaling group %q", taskName, window.ScalingGroupId)
+		if _, err := s.client.CreateScheduledTask(request); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	if existing.ScheduledAction == scalingAction &&
+		existing.LaunchTime == window.LaunchTime &&
+		existing.RecurrenceType == window.RecurrenceType &&
+		existing.RecurrenceValue == window.RecurrenceValue &&
+		existing.RecurrenceEndTime == window.RecurrenceEndTime &&
+		existing.TaskEnabled {
+		klog.V(4).Infof("Scheduled scaling task %q for scaling group %q is up to date", taskName, window.ScalingGroupId)
+		return nil
+	}
+
+	request := ess.CreateModifyScheduledTaskRequest()
+	request.ScheduledTaskId = existing.ScheduledTaskId
+	request.ScheduledAction = scalingAction
+	request.LaunchTime = window.LaunchTime
+	request.RecurrenceType = window.RecurrenceType
+	request.RecurrenceValue = window.RecurrenceValue
+	request.RecurrenceEndTime = window.RecurrenceEndTime
+	request.TaskEnabled = requests.NewBoolean(true)
+
+	klog.V(3).Infof("Updating scheduled scaling task %q for scaling group %q", taskName, window.ScalingGroupId)
+	_, err = s.client.ModifyScheduledTask(request)
+	return err
+}
+
+// findScheduledTask looks up a previously reconciled ScheduledTask by name,
+// returning nil if none exists yet.
+func (s *ScheduledScaling) findScheduledTask(taskName string) (*ess.ScheduledTask, error) {
+	request := ess.CreateDescribeScheduledTasksRequest()
+	request.ScheduledTaskName = taskName
+
+	response, err := s.client.DescribeScheduledTasks(request)
+	if err != nil {
+		return nil, err
+	}
+	for i := range response.ScheduledTasks.ScheduledTask {
+		task := response.ScheduledTasks.ScheduledTask[i]
+		if task.ScheduledTaskName == taskName {
+			return &task, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alicloud
+
+import (
+	"testing"
+
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/sdk/requests"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/alicloud/alibaba-cloud-sdk-go/services/ess"
+)
+
+// fakeScheduledTaskClient is an in-memory scheduledTaskClient keyed by
+// ScheduledTaskName, standing in for the live ESS endpoint in tests.
+type fakeScheduledTaskClient struct {
+	tasks map[string]*ess.ScheduledTask
+}
+
+func newFakeScheduledTaskClient() *fakeScheduledTaskClient {
+	return &fakeScheduledTaskClient{tasks: make(map[string]*ess.ScheduledTask)}
+}
+
+func (f *fakeScheduledTaskClient) CreateScheduledTask(request *ess.CreateScheduledTaskRequest) (*ess.CreateScheduledTaskResponse, error) {
+	f.tasks[request.ScheduledTaskName] = &ess.ScheduledTask{
+		ScheduledTaskId:   request.ScheduledTaskName,
+		ScheduledTaskName: request.ScheduledTaskName,
+		ScheduledAction:   request.ScheduledAction,
+		LaunchTime:        request.LaunchTime,
+		RecurrenceType:    request.RecurrenceType,
+		RecurrenceValue:   request.RecurrenceValue,
+		RecurrenceEndTime: request.RecurrenceEndTime,
+		TaskEnabled:       request.TaskEnabled == requests.NewBoolean(true),
+	}
+	return ess.CreateCreateScheduledTaskResponse(), nil
+}
+
+func (f *fakeScheduledTaskClient) ModifyScheduledTask(request *ess.ModifyScheduledTaskRequest) (*ess.ModifyScheduledTaskResponse, error) {
+	task, ok := f.tasks[request.ScheduledTaskId]
+	if !ok {
+		task = &ess.ScheduledTask{ScheduledTaskId: request.ScheduledTaskId, ScheduledTaskName: request.ScheduledTaskId}
+		f.tasks[request.ScheduledTaskId] = task
+	}
+	task.ScheduledAction = request.ScheduledAction
+	task.LaunchTime = request.LaunchTime
+	task.RecurrenceType = request.RecurrenceType
+	task.RecurrenceValue = request.RecurrenceValue
+	task.RecurrenceEndTime = request.RecurrenceEndTime
+	task.TaskEnabled = true
+	return ess.CreateModifyScheduledTaskResponse(), nil
+}
+
+func (f *fakeScheduledTaskClient) DescribeScheduledTasks(request *ess.DescribeScheduledTasksRequest) (*ess.DescribeScheduledTasksResponse, error) {
+	response := ess.CreateDescribeScheduledTasksResponse()
+	if task, ok := f.tasks[request.ScheduledTaskName]; ok {
+		response.ScheduledTasks.ScheduledTask = []ess.ScheduledTask{*task}
+		response.TotalCount = 1
+	}
+	return response, nil
+}
+
+func TestScheduledScalingReconcileCreatesMissingTask(t *testing.T) {
+	client := newFakeScheduledTaskClient()
+	scheduler := NewScheduledScaling(client)
+
+	window := ScheduledScalingWindow{
+		ScalingGroupId:  "asg-1",
+		MinSize:         2,
+		MaxSize:         5,
+		LaunchTime:      "08:00",
+		RecurrenceType:  "Daily",
+		RecurrenceValue: "1",
+	}
+
+	if err := scheduler.Reconcile([]ScheduledScalingWindow{window}); err != nil {
+		t.Fatalf("Reconcile returned error: %v", err)
+	}
+
+	taskName := scheduledTaskNamePrefix + window.ScalingGroupId
+	task, ok := client.tasks[taskName]
+	if !ok {
+		t.Fatalf("expected scheduled task %q to be created", taskName)
+	}
+	if task.ScheduledAction != "MinSize:2,MaxSize:5" {
+		t.Errorf("ScheduledAction = %q, want MinSize:2,MaxSize:5", task.ScheduledAction)
+	}
+	if !task.TaskEnabled {
+		t.Errorf("expected created task to be enabled")
+	}
+}
+
+func TestScheduledScalingReconcileUpdatesChangedTask(t *testing.T) {
+	client := newFakeScheduledTaskClient()
+	scheduler := NewScheduledScaling(client)
+
+	window := ScheduledScalingWindow{ScalingGroupId: "asg-1", MinSize: 2, MaxSize: 5, LaunchTime: "08:00", RecurrenceType: "Daily", RecurrenceValue: "1"}
+	if err := scheduler.Reconcile([]ScheduledScalingWindow{window}); err != nil {
+		t.Fatalf("initial Reconcile returned error: %v", err)
+	}
+
+	window.MaxSize = 10
+	if err := scheduler.Reconcile([]ScheduledScalingWindow{window}); err != nil {
+		t.Fatalf("second Reconcile returned error: %v", err)
+	}
+
+	taskName := scheduledTaskNamePrefix + window.ScalingGroupId
+	task := client.tasks[taskName]
+	if task.ScheduledAction != "MinSize:2,MaxSize:10" {
+		t.Errorf("ScheduledAction = %q, want MinSize:2,MaxSize:10 after update", task.ScheduledAction)
+	}
+	if len(client.tasks) != 1 {
+		t.Errorf("expected exactly one task after update, got %d", len(client.tasks))
+	}
+}
+
+func TestScheduledScalingReconcileIsIdempotent(t *testing.T) {
+	client := newFakeScheduledTaskClient()
+	scheduler := NewScheduledScaling(client)
+
+	window := ScheduledScalingWindow{ScalingGroupId: "asg-1", MinSize: 2, MaxSize: 5, LaunchTime: "08:00", RecurrenceType: "Daily", RecurrenceValue: "1"}
+	for i := 0; i < 2; i++ {
+		if err := scheduler.Reconcile([]ScheduledScalingWindow{window}); err != nil {
+			t.Fatalf("Reconcile #%d returned error: %v", i, err)
+		}
+	}
+
+	if len(client.tasks) != 1 {
+		t.Errorf("expected a single reconciled task after repeated Reconcile calls, got %d", len(client.tasks))
+	}
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"k8s.io/klog"
+)
+
+const defaultAzureCacheRefreshInterval = 15 * time.Second
+
+// AzureCache centralizes the VMSS-related state that used to live in the
+// package-level scaleSetStatusCache: the VMSS list per resource group, the
+// instance->nodegroup reverse mapping used by GetAsgForInstance/Belongs, and
+// the per-VMSS instance lists. It has no refresh loop of its own: callers
+// fill it through the Set* methods, and an entry reads as a miss once
+// refreshInterval has passed since it was set. No jitter is applied to that
+// interval. Invalidation can be scoped to one VMSS or one resource group
+// instead of blowing away everything.
+type AzureCache struct {
+	mutex           sync.Mutex
+	refreshInterval time.Duration
+
+	// vmssByResourceGroup holds the last listing of VirtualMachineScaleSets,
+	// keyed by resource group then VMSS name.
+	vmssByResourceGroup map[string]map[string]compute.VirtualMachineScaleSet
+	vmssLastRefresh     map[string]time.Time
+
+	// instanceToNodeGroup maps a VM providerID to the name of the ScaleSet
+	// (node group) it belongs to, used by GetAsgForInstance/Belongs.
+	instanceToNodeGroup map[string]string
+
+	// instancesByVMSS holds the last listing of VMs per VMSS name.
+	instancesByVMSS      map[string][]compute.VirtualMachineScaleSetVM
+	instancesLastRefresh map[string]time.Time
+}
+
+// NewAzureCache creates an AzureCache with the given base refresh interval.
+// A zero interval falls back to defaultAzureCacheRefreshInterval.
+func NewAzureCache(refreshInterval time.Duration) *AzureCache {
+	if refreshInterval <= 0 {
+		refreshInterval = defaultAzureCacheRefreshInterval
+	}
+	return &AzureCache{
+		refreshInterval:      refreshInterval,
+		vmssByResourceGroup:  make(map[string]map[string]compute.VirtualMachineScaleSet),
+		vmssLastRefresh:      make(map[string]time.Time),
+		instanceToNodeGroup:  make(map[string]string),
+		instancesByVMSS:      make(map[string][]compute.VirtualMachineScaleSetVM),
+		instancesLastRefresh: make(map[string]time.Time),
+	}
+}
+
+// jitteredInterval adds up to 20% random jitter to the configured refresh
+// interval. GetVMSS and GetVMSSInstances do not use it; they compare
+// against refreshInterval as is.
+func (c *AzureCache) jitteredInterval() time.Duration {
+	jitter := time.Duration(rand.Int63n(int64(c.refreshInterval) / 5))
+	return c.refreshInterval + jitter
+}
+
+// SetVMSSList replaces the cached VMSS list for resourceGroup and restarts
+// its refresh interval. The instance->nodegroup reverse mapping is left
+// untouched; SetNodeGroupForInstance maintains it.
+func (c *AzureCache) SetVMSSList(resourceGroup string, vmssList []compute.VirtualMachineScaleSet) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	byName := make(map[string]compute.VirtualMachineScaleSet, len(vmssList))
+	for _, vmss := range vmssList {
+		byName[*vmss.Name] = vmss
+	}
+	c.vmssByResourceGroup[resourceGroup] = byName
+	c.vmssLastRefresh[resourceGroup] = time.Now()
+}
+
+// GetVMSS returns the cached VMSS by resource group and name, and whether
+// the cached entry is still within its refresh interval.
+func (c *AzureCache) GetVMSS(resourceGroup, name string) (compute.VirtualMachineScaleSet, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if lastRefresh, ok := c.vmssLastRefresh[resourceGroup]; !ok || lastRefresh.Add(c.refreshInterval).Before(time.Now()) {
+		return compute.VirtualMachineScaleSet{}, false
+	}
+	vmss, ok := c.vmssByResourceGroup[resourceGroup][name]
+	return vmss, ok
+}
+
+// InvalidateVMSS drops the cached entry for a single resource group, forcing
+// the next GetVMSS to report a miss without affecting other resource groups.
+func (c *AzureCache) InvalidateVMSS(resourceGroup string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	delete(c.vmssLastRefresh, resourceGroup)
+}
+
+// SetNodeGroupForInstance records which node group (ScaleSet) a VM
+// providerID belongs to.
+func (c *AzureCache) SetNodeGroupForInstance(providerID, nodeGroup string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.instanceToNodeGroup[providerID] = nodeGroup
+}
+
+// NodeGroupForInstance returns the node group a VM providerID belongs to, if
+// known.
+func (c *AzureCache) NodeGroupForInstance(providerID string) (string, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	nodeGroup, ok := c.instanceToNodeGroup[providerID]
+	return nodeGroup, ok
+}
+
+// SetVMSSInstances replaces the cached VM list for a single VMSS.
+func (c *AzureCache) SetVMSSInstances(vmssName string, vms []compute.VirtualMachineScaleSetVM) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.instancesByVMSS[vmssName] = vms
+	c.instancesLastRefresh[vmssName] = time.Now()
+}
+
+// GetVMSSInstances returns the cached VM list for a single VMSS, and whether
+// it is still within its refresh interval.
+func (c *AzureCache) GetVMSSInstances(vmssName string) ([]compute.VirtualMachineScaleSetVM, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	lastRefresh, ok := c.instancesLastRefresh[vmssName]
+	if !ok || lastRefresh.Add(c.refreshInterval).Before(time.Now()) {
+		return nil, false
+	}
+	return c.instancesByVMSS[vmssName], true
+}
+
+// InvalidateVMSSInstances drops the cached VM list for a single VMSS.
+func (c *AzureCache) InvalidateVMSSInstances(vmssName string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	delete(c.instancesLastRefresh, vmssName)
+	klog.V(5).Infof("AzureCache: invalidated instance cache for VMSS %q", vmssName)
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider"
+)
+
+// Config holds the subset of the Azure cloud provider configuration that
+// ScaleSet and AzureManager consult directly. CloudProviderBackoff* mirror
+// the flags of the same name on the autoscaler's cloud-provider-independent
+// backoff options.
+type Config struct {
+	ResourceGroup string
+
+	CloudProviderBackoffRetries  int
+	CloudProviderBackoffDuration int
+	CloudProviderBackoffExponent float64
+	CloudProviderBackoffJitter   float64
+
+	// EnableForceDelete is the cluster-wide default for
+	// ScaleSet.forceDeletionEnabled; see forceDeleteTagKey on ScaleSet for
+	// the per-VMSS override.
+	EnableForceDelete bool
+
+	// vmssCacheTTL overrides defaultAzureCacheRefreshInterval when
+	// non-zero; see AzureManager.azureCache.
+	vmssCacheTTL time.Duration
+}
+
+// azClient bundles the wrapper clients AzureManager and ScaleSet use to talk
+// to the VMSS and VM ARM APIs.
+type azClient struct {
+	virtualMachineScaleSetsClient   VirtualMachineScaleSetsClient
+	virtualMachinesClient           VirtualMachinesClient
+	virtualMachineScaleSetVMsClient VirtualMachineScaleSetVMsClient
+}
+
+// AzureManager handles Azure communication and the node-group registry for
+// the Azure cloud provider.
+type AzureManager struct {
+	config   *Config
+	azClient *azClient
+
+	// azureCache centralizes the VMSS/instance state shared by every
+	// ScaleSet; see AzureCache.
+	azureCache *AzureCache
+
+	mutex            sync.Mutex
+	nodeGroupByAsgID map[string]cloudprovider.NodeGroup
+}
+
+// CreateAzureManager builds an AzureManager for the given config and ARM
+// clients, wiring up a freshly constructed AzureCache shared by every
+// ScaleSet the manager creates.
+func CreateAzureManager(config *Config, azClient *azClient) (*AzureManager, error) {
+	if config == nil {
+		return nil, fmt.Errorf("azure: config is required")
+	}
+
+	return &AzureManager{
+		config:           config,
+		azClient:         azClient,
+		azureCache:       NewAzureCache(config.vmssCacheTTL),
+		nodeGroupByAsgID: make(map[string]cloudprovider.NodeGroup),
+	}, nil
+}
+
+// RegisterNodeGroup records asg under asg.Id(), so that GetAsgForInstance can
+// return it for any instance that AzureCache maps to that node group name.
+func (m *AzureManager) RegisterNodeGroup(asg cloudprovider.NodeGroup) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.nodeGroupByAsgID[asg.Id()] = asg
+}
+
+// GetAsgForInstance returns the node group owning ref, using
+// AzureCache.NodeGroupForInstance to resolve the VMSS name and falling back
+// to an error if it isn't (yet) known.
+func (m *AzureManager) GetAsgForInstance(ref *azureRef) (cloudprovider.NodeGroup, error) {
+	nodeGroupName, ok := m.azureCache.NodeGroupForInstance(ref.Name)
+	if !ok {
+		return nil, fmt.Errorf("azure: no node group known for instance %q", ref.Name)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	asg, ok := m.nodeGroupByAsgID[nodeGroupName]
+	if !ok {
+		return nil, fmt.Errorf("azure: node group %q not registered with the manager", nodeGroupName)
+	}
+	return asg, nil
+}
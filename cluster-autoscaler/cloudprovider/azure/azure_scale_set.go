@@ -17,6 +17,8 @@ limitations under the License.
 package azure
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -24,28 +26,37 @@ import (
 	"time"
 
 	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider"
 	"k8s.io/autoscaler/cluster-autoscaler/config/dynamic"
 	"k8s.io/klog"
 	schedulernodeinfo "k8s.io/kubernetes/pkg/scheduler/nodeinfo"
 
-	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-10-01/compute"
+	// 2021-11-01 is the first compute API version exposing both
+	// VirtualMachineScaleSet.ExtendedLocation (edge zones) and
+	// OrchestrationMode/compute.Flexible; 2018-10-01 has neither.
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
 	"github.com/Azure/go-autorest/autorest"
 )
 
+// ErrVMSSBeingDeleted is returned by createOrUpdateVmssWithRetry when the
+// VMSS is already in a terminal Deleting/Deallocating provisioning state.
+// Callers can treat it as non-retryable instead of invalidating caches and
+// retrying a CreateOrUpdate that can only fail again.
+var ErrVMSSBeingDeleted = errors.New("vmss is being deleted, skipping CreateOrUpdate")
+
+const vmssProvisioningStateDeallocating = "Deallocating"
+
 var (
 	defaultVmssSizeRefreshPeriod = 15 * time.Second
 	vmssInstancesRefreshPeriod   = 5 * time.Minute
 	vmssContextTimeout           = 3 * time.Minute
-	vmssSizeMutex                sync.Mutex
+	// flexibleDeleteConcurrency bounds the worker pool used to delete
+	// standalone VMs that make up a Flexible orchestration mode VMSS.
+	flexibleDeleteConcurrency = 10
+	vmssSizeMutex             sync.Mutex
 )
 
-var scaleSetStatusCache struct {
-	lastRefresh time.Time
-	mutex       sync.Mutex
-	scaleSets   map[string]compute.VirtualMachineScaleSet
-}
-
 func init() {
 	// In go-autorest SDK https://github.com/Azure/go-autorest/blob/master/autorest/sender.go#L242,
 	// if ARM returns http.StatusTooManyRequests, the sender doesn't increase the retry attempt count,
@@ -77,6 +88,13 @@ type ScaleSet struct {
 	instanceMutex       sync.Mutex
 	instanceCache       []cloudprovider.Instance
 	lastInstanceRefresh time.Time
+
+	// vmDeletionsInProgress tracks the VM providerIDs for which a
+	// DeleteInstancesAsync call has been dispatched but not yet confirmed,
+	// so that a concurrent DeleteInstances/Nodes call can see them as
+	// in-flight even before the VMSS List API reflects the delete.
+	deletionsMutex        sync.Mutex
+	vmDeletionsInProgress map[string]struct{}
 }
 
 // NewScaleSet creates a new NewScaleSet.
@@ -89,6 +107,8 @@ func NewScaleSet(spec *dynamic.NodeGroupSpec, az *AzureManager) (*ScaleSet, erro
 		maxSize: spec.MaxSize,
 		manager: az,
 		curSize: -1,
+
+		vmDeletionsInProgress: make(map[string]struct{}),
 	}
 
 	if az.config.VmssCacheTTL != 0 {
@@ -97,6 +117,8 @@ func NewScaleSet(spec *dynamic.NodeGroupSpec, az *AzureManager) (*ScaleSet, erro
 		scaleSet.sizeRefreshPeriod = defaultVmssSizeRefreshPeriod
 	}
 
+	az.RegisterNodeGroup(scaleSet)
+
 	return scaleSet, nil
 }
 
@@ -133,36 +155,23 @@ func (scaleSet *ScaleSet) MaxSize() int {
 }
 
 func (scaleSet *ScaleSet) getVMSSInfo() (compute.VirtualMachineScaleSet, error) {
-	scaleSetStatusCache.mutex.Lock()
-	defer scaleSetStatusCache.mutex.Unlock()
+	resourceGroup := scaleSet.manager.config.ResourceGroup
+	cache := scaleSet.manager.azureCache
 
-	if scaleSetStatusCache.lastRefresh.Add(scaleSet.sizeRefreshPeriod).After(time.Now()) {
-		if status, exists := scaleSetStatusCache.scaleSets[scaleSet.Name]; exists {
-			return status, nil
-		}
+	if vmss, cached := cache.GetVMSS(resourceGroup, scaleSet.Name); cached {
+		return vmss, nil
 	}
 
-	var allVMSS []compute.VirtualMachineScaleSet
-	var err error
-
-	allVMSS, err = scaleSet.getAllVMSSInfo()
+	allVMSS, err := scaleSet.getAllVMSSInfo()
 	if err != nil {
 		return compute.VirtualMachineScaleSet{}, err
 	}
+	cache.SetVMSSList(resourceGroup, allVMSS)
 
-	var newStatus = make(map[string]compute.VirtualMachineScaleSet)
-	for _, vmss := range allVMSS {
-		newStatus[*vmss.Name] = vmss
-	}
-
-	scaleSetStatusCache.lastRefresh = time.Now()
-	scaleSetStatusCache.scaleSets = newStatus
-
-	if _, exists := scaleSetStatusCache.scaleSets[scaleSet.Name]; !exists {
-		return compute.VirtualMachineScaleSet{}, fmt.Errorf("could not find vmss: %s", scaleSet.Name)
+	if vmss, cached := cache.GetVMSS(resourceGroup, scaleSet.Name); cached {
+		return vmss, nil
 	}
-
-	return scaleSetStatusCache.scaleSets[scaleSet.Name], nil
+	return compute.VirtualMachineScaleSet{}, fmt.Errorf("could not find vmss: %s", scaleSet.Name)
 }
 
 func (scaleSet *ScaleSet) getAllVMSSInfo() ([]compute.VirtualMachineScaleSet, error) {
@@ -219,7 +228,9 @@ func (scaleSet *ScaleSet) GetScaleSetSize() (int64, error) {
 	return scaleSet.getCurSize()
 }
 
-func (scaleSet *ScaleSet) waitForDeleteInstances(future compute.VirtualMachineScaleSetsDeleteInstancesFuture, requiredIds *compute.VirtualMachineScaleSetVMInstanceRequiredIDs) {
+func (scaleSet *ScaleSet) waitForDeleteInstances(future compute.VirtualMachineScaleSetsDeleteInstancesFuture, requiredIds *compute.VirtualMachineScaleSetVMInstanceRequiredIDs, providerIDs []string) {
+	defer scaleSet.unmarkInstancesDeleting(providerIDs)
+
 	ctx, cancel := getContextWithCancel()
 	defer cancel()
 	klog.V(3).Infof("Calling virtualMachineScaleSetsClient.WaitForDeleteInstances(%v)", requiredIds.InstanceIds)
@@ -232,6 +243,36 @@ func (scaleSet *ScaleSet) waitForDeleteInstances(future compute.VirtualMachineSc
 	klog.Errorf("virtualMachineScaleSetsClient.WaitForDeleteInstances for instances %v failed with error: %v", requiredIds.InstanceIds, err)
 }
 
+// markInstancesDeleting records providerIDs as having an in-flight delete so
+// that Nodes() and DeleteInstances() see them as InstanceDeleting even
+// before the VMSS List API catches up.
+func (scaleSet *ScaleSet) markInstancesDeleting(providerIDs []string) {
+	scaleSet.deletionsMutex.Lock()
+	defer scaleSet.deletionsMutex.Unlock()
+	for _, providerID := range providerIDs {
+		scaleSet.vmDeletionsInProgress[providerID] = struct{}{}
+	}
+}
+
+// unmarkInstancesDeleting clears the in-flight delete marker for providerIDs,
+// whether the delete ultimately succeeded or failed.
+func (scaleSet *ScaleSet) unmarkInstancesDeleting(providerIDs []string) {
+	scaleSet.deletionsMutex.Lock()
+	defer scaleSet.deletionsMutex.Unlock()
+	for _, providerID := range providerIDs {
+		delete(scaleSet.vmDeletionsInProgress, providerID)
+	}
+}
+
+// isInstanceDeleting reports whether providerID has a delete dispatched but
+// not yet resolved.
+func (scaleSet *ScaleSet) isInstanceDeleting(providerID string) bool {
+	scaleSet.deletionsMutex.Lock()
+	defer scaleSet.deletionsMutex.Unlock()
+	_, found := scaleSet.vmDeletionsInProgress[providerID]
+	return found
+}
+
 // updateVMSSCapacity invokes virtualMachineScaleSetsClient to update the capacity for VMSS.
 func (scaleSet *ScaleSet) updateVMSSCapacity(size int64) error {
 	var vmssInfo compute.VirtualMachineScaleSet
@@ -249,14 +290,15 @@ func (scaleSet *ScaleSet) updateVMSSCapacity(size int64) error {
 
 	// Compose a new VMSS for updating.
 	op := compute.VirtualMachineScaleSet{
-		Name:     vmssInfo.Name,
-		Sku:      vmssInfo.Sku,
-		Location: vmssInfo.Location,
+		Name:             vmssInfo.Name,
+		Sku:              vmssInfo.Sku,
+		Location:         vmssInfo.Location,
+		ExtendedLocation: vmssInfo.ExtendedLocation,
 	}
 	ctx, cancel := getContextWithTimeout(vmssContextTimeout)
 	defer cancel()
 	klog.V(3).Infof("Waiting for virtualMachineScaleSetsClient.CreateOrUpdateAsync(%s)", scaleSet.Name)
-	future, err := scaleSet.manager.azClient.virtualMachineScaleSetsClient.CreateOrUpdateAsync(ctx, scaleSet.manager.config.ResourceGroup, scaleSet.Name, op)
+	future, err := scaleSet.createOrUpdateVmssWithRetry(ctx, op)
 	if err != nil {
 		klog.Errorf("virtualMachineScaleSetsClient.CreateOrUpdateAsync for scale set %q failed: %v", scaleSet.Name, err)
 		return err
@@ -270,6 +312,75 @@ func (scaleSet *ScaleSet) updateVMSSCapacity(size int64) error {
 	return nil
 }
 
+// createOrUpdateVmssWithRetry wraps virtualMachineScaleSetsClient.CreateOrUpdateAsync
+// with an exponential backoff so that transient throttling doesn't bubble
+// straight up into a retry storm. It first re-fetches the VMSS and
+// short-circuits with ErrVMSSBeingDeleted if the VMSS is already in a
+// terminal Deleting/Deallocating provisioning state, since a CreateOrUpdate
+// against it only produces a confusing "the vmss is being deleted" error.
+func (scaleSet *ScaleSet) createOrUpdateVmssWithRetry(ctx context.Context, op compute.VirtualMachineScaleSet) (compute.VirtualMachineScaleSetsCreateOrUpdateFuture, error) {
+	resourceGroup := scaleSet.manager.config.ResourceGroup
+
+	vmss, err := scaleSet.manager.azClient.virtualMachineScaleSetsClient.Get(ctx, resourceGroup, scaleSet.Name)
+	if err != nil {
+		return compute.VirtualMachineScaleSetsCreateOrUpdateFuture{}, err
+	}
+	if vmss.ProvisioningState != nil {
+		switch *vmss.ProvisioningState {
+		case string(compute.ProvisioningStateDeleting), vmssProvisioningStateDeallocating:
+			return compute.VirtualMachineScaleSetsCreateOrUpdateFuture{}, ErrVMSSBeingDeleted
+		}
+	}
+
+	backoff := vmssRetryBackoff(scaleSet.manager.config)
+
+	var future compute.VirtualMachineScaleSetsCreateOrUpdateFuture
+	retryErr := wait.ExponentialBackoff(backoff, func() (bool, error) {
+		future, err = scaleSet.manager.azClient.virtualMachineScaleSetsClient.CreateOrUpdateAsync(ctx, resourceGroup, scaleSet.Name, op)
+		if err == nil {
+			return true, nil
+		}
+		if isAzureRequestsThrottled(err) {
+			klog.Warningf("virtualMachineScaleSetsClient.CreateOrUpdateAsync for %q is throttled, backing off before retrying", scaleSet.Name)
+			return false, nil
+		}
+		return false, err
+	})
+	if retryErr == wait.ErrWaitTimeout && err != nil {
+		// Report the last underlying throttling error rather than the
+		// less useful "timed out waiting for the condition".
+		return future, err
+	}
+	if retryErr != nil {
+		return future, retryErr
+	}
+	return future, err
+}
+
+// defaultCloudProviderBackoffRetries is substituted for
+// Config.CloudProviderBackoffRetries when it's left at its zero value.
+// wait.ExponentialBackoff's loop is "for backoff.Steps > 0", so a
+// zero-value Steps makes it return wait.ErrWaitTimeout without ever
+// invoking the condition function - i.e. without ever calling
+// CreateOrUpdateAsync at all.
+const defaultCloudProviderBackoffRetries = 1
+
+// vmssRetryBackoff builds the wait.Backoff createOrUpdateVmssWithRetry
+// retries CreateOrUpdateAsync with, defaulting Steps to
+// defaultCloudProviderBackoffRetries when config leaves it unset.
+func vmssRetryBackoff(config *Config) wait.Backoff {
+	steps := config.CloudProviderBackoffRetries
+	if steps < 1 {
+		steps = defaultCloudProviderBackoffRetries
+	}
+	return wait.Backoff{
+		Steps:    steps,
+		Duration: time.Duration(config.CloudProviderBackoffDuration) * time.Second,
+		Factor:   config.CloudProviderBackoffExponent,
+		Jitter:   config.CloudProviderBackoffJitter,
+	}
+}
+
 func (scaleSet *ScaleSet) waitForUpdateVMSSCapacity(future compute.VirtualMachineScaleSetsCreateOrUpdateFuture) {
 	var err error
 
@@ -332,6 +443,44 @@ func (scaleSet *ScaleSet) IncreaseSize(delta int) error {
 	return scaleSet.SetScaleSetSize(size + int64(delta))
 }
 
+// forceDeleteTagKey is the per-VMSS tag operators can set to override
+// AzureManager.config.EnableForceDelete in either direction, without needing
+// to restart the autoscaler with a different global flag.
+const forceDeleteTagKey = "k8s.io/cluster-autoscaler/force-delete"
+
+// forceDeletionEnabled reports whether instance deletes for this scale set
+// should pass forceDeletion=true, which skips the graceful
+// shutdown/deallocate path on stuck or unreachable VMs. The per-VMSS
+// forceDeleteTagKey tag takes precedence over the global
+// AzureManager.config.EnableForceDelete default.
+func (scaleSet *ScaleSet) forceDeletionEnabled(vmssInfo compute.VirtualMachineScaleSet) bool {
+	if vmssInfo.Tags != nil {
+		if tag, ok := vmssInfo.Tags[forceDeleteTagKey]; ok && tag != nil {
+			return strings.EqualFold(*tag, "true")
+		}
+	}
+	return scaleSet.manager.config.EnableForceDelete
+}
+
+// isForceDeletionUnsupported reports whether err indicates that the
+// installed VMSS API version doesn't accept the forceDeletion parameter, so
+// the caller can gracefully retry without it.
+func isForceDeletionUnsupported(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(err.Error(), "forceDeletion")
+}
+
+// isFlexibleOrchestrationMode reports whether vmssInfo describes a Flexible
+// orchestration mode VMSS, where member VMs are standalone
+// Microsoft.Compute/virtualMachines resources rather than VMSS VM
+// sub-resources. An empty OrchestrationMode means Uniform, the historical
+// default.
+func isFlexibleOrchestrationMode(vmssInfo compute.VirtualMachineScaleSet) bool {
+	return vmssInfo.OrchestrationMode == compute.Flexible
+}
+
 // GetScaleSetVms returns list of nodes for the given scale set.
 func (scaleSet *ScaleSet) GetScaleSetVms() ([]compute.VirtualMachineScaleSetVM, error) {
 	klog.V(4).Infof("GetScaleSetVms: starts")
@@ -349,6 +498,39 @@ func (scaleSet *ScaleSet) GetScaleSetVms() ([]compute.VirtualMachineScaleSetVM,
 	return vmList, nil
 }
 
+// GetFlexibleScaleSetVms returns the standalone virtualMachines resources
+// that make up a Flexible orchestration mode VMSS, filtered to the ones
+// whose VirtualMachineScaleSet reference points at this ScaleSet.
+func (scaleSet *ScaleSet) GetFlexibleScaleSetVms() ([]compute.VirtualMachine, error) {
+	klog.V(4).Infof("GetFlexibleScaleSetVms: starts")
+	ctx, cancel := getContextWithTimeout(vmssContextTimeout)
+	defer cancel()
+
+	resourceGroup := scaleSet.manager.config.ResourceGroup
+	vmssInfo, err := scaleSet.getVMSSInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	allVMs, err := scaleSet.manager.azClient.virtualMachinesClient.List(ctx, resourceGroup)
+	if err != nil {
+		klog.Errorf("virtualMachinesClient.List failed for %s: %v", scaleSet.Name, err)
+		return nil, err
+	}
+
+	members := make([]compute.VirtualMachine, 0, len(allVMs))
+	for _, vm := range allVMs {
+		if vm.VirtualMachineProperties == nil || vm.VirtualMachineProperties.VirtualMachineScaleSet == nil {
+			continue
+		}
+		if *vm.VirtualMachineProperties.VirtualMachineScaleSet.ID == *vmssInfo.ID {
+			members = append(members, vm)
+		}
+	}
+
+	return members, nil
+}
+
 // DecreaseTargetSize decreases the target size of the node group. This function
 // doesn't permit to delete any existing node and can be used only to reduce the
 // request for new nodes that have not been yet fulfilled. Delta should be negative.
@@ -399,7 +581,16 @@ func (scaleSet *ScaleSet) DeleteInstances(instances []*azureRef) error {
 		return err
 	}
 
+	vmssInfo, err := scaleSet.getVMSSInfo()
+	if err != nil {
+		return err
+	}
+	if isFlexibleOrchestrationMode(vmssInfo) {
+		return scaleSet.deleteFlexibleInstances(instances, commonAsg)
+	}
+
 	instanceIDs := []string{}
+	providerIDs := []string{}
 	for _, instance := range instances {
 		asg, err := scaleSet.manager.GetAsgForInstance(instance)
 		if err != nil {
@@ -415,6 +606,11 @@ func (scaleSet *ScaleSet) DeleteInstances(instances []*azureRef) error {
 			continue
 		}
 
+		if scaleSet.isInstanceDeleting(instance.Name) {
+			klog.V(3).Infof("Skipping deleting instance %s as a delete is already in flight", instance.Name)
+			continue
+		}
+
 		instanceID, err := getLastSegment(instance.Name)
 		if err != nil {
 			klog.Errorf("getLastSegment failed with error: %v", err)
@@ -422,6 +618,7 @@ func (scaleSet *ScaleSet) DeleteInstances(instances []*azureRef) error {
 		}
 
 		instanceIDs = append(instanceIDs, instanceID)
+		providerIDs = append(providerIDs, instance.Name)
 	}
 
 	// nothing to delete
@@ -438,12 +635,22 @@ func (scaleSet *ScaleSet) DeleteInstances(instances []*azureRef) error {
 	defer cancel()
 	resourceGroup := scaleSet.manager.config.ResourceGroup
 
+	scaleSet.markInstancesDeleting(providerIDs)
+
+	forceDeletion := scaleSet.forceDeletionEnabled(vmssInfo)
 	scaleSet.instanceMutex.Lock()
-	klog.V(3).Infof("Calling virtualMachineScaleSetsClient.DeleteInstancesAsync(%v)", requiredIds.InstanceIds)
-	future, err := scaleSet.manager.azClient.virtualMachineScaleSetsClient.DeleteInstancesAsync(ctx, resourceGroup, commonAsg.Id(), *requiredIds)
+	klog.V(3).Infof("Calling virtualMachineScaleSetsClient.DeleteInstancesAsync(%v), forceDeletion=%v", requiredIds.InstanceIds, forceDeletion)
+	future, err := scaleSet.manager.azClient.virtualMachineScaleSetsClient.DeleteInstancesAsync(ctx, resourceGroup, commonAsg.Id(), *requiredIds, &forceDeletion)
 	scaleSet.instanceMutex.Unlock()
+	if forceDeletion && isForceDeletionUnsupported(err) {
+		klog.Warningf("virtualMachineScaleSetsClient.DeleteInstancesAsync with forceDeletion=true is not supported by the installed API version, falling back to a graceful delete")
+		scaleSet.instanceMutex.Lock()
+		future, err = scaleSet.manager.azClient.virtualMachineScaleSetsClient.DeleteInstancesAsync(ctx, resourceGroup, commonAsg.Id(), *requiredIds, nil)
+		scaleSet.instanceMutex.Unlock()
+	}
 	if err != nil {
 		klog.Errorf("virtualMachineScaleSetsClient.DeleteInstancesAsync for instances %v failed: %v", requiredIds.InstanceIds, err)
+		scaleSet.unmarkInstancesDeleting(providerIDs)
 		return err
 	}
 
@@ -453,10 +660,106 @@ func (scaleSet *ScaleSet) DeleteInstances(instances []*azureRef) error {
 	scaleSet.curSize -= int64(len(instanceIDs))
 	scaleSet.sizeMutex.Unlock()
 
-	go scaleSet.waitForDeleteInstances(future, requiredIds)
+	go scaleSet.waitForDeleteInstances(future, requiredIds, providerIDs)
 	return nil
 }
 
+// deleteFlexibleInstances deletes instances that belong to a Flexible
+// orchestration mode VMSS. Unlike Uniform mode, member VMs are standalone
+// Microsoft.Compute/virtualMachines resources, so each is deleted
+// individually through virtualMachinesClient rather than through the VMSS
+// instances endpoint. Deletes are dispatched from a bounded worker pool so
+// that a large batch doesn't open unboundedly many Azure API calls at once.
+func (scaleSet *ScaleSet) deleteFlexibleInstances(instances []*azureRef, commonAsg cloudprovider.NodeGroup) error {
+	resourceGroup := scaleSet.manager.config.ResourceGroup
+
+	providerIDs := make([]string, 0, len(instances))
+	vmNames := make([]string, 0, len(instances))
+	for _, instance := range instances {
+		asg, err := scaleSet.manager.GetAsgForInstance(instance)
+		if err != nil {
+			return err
+		}
+		if !strings.EqualFold(asg.Id(), commonAsg.Id()) {
+			return fmt.Errorf("cannot delete instance (%s) which don't belong to the same Scale Set (%q)", instance.Name, commonAsg)
+		}
+
+		if cpi, found := scaleSet.getInstanceByProviderID(instance.Name); found && cpi.Status != nil && cpi.Status.State == cloudprovider.InstanceDeleting {
+			klog.V(3).Infof("Skipping deleting instance %s as its current state is deleting", instance.Name)
+			continue
+		}
+		if scaleSet.isInstanceDeleting(instance.Name) {
+			klog.V(3).Infof("Skipping deleting instance %s as a delete is already in flight", instance.Name)
+			continue
+		}
+
+		vmName, err := getLastSegment(instance.Name)
+		if err != nil {
+			klog.Errorf("getLastSegment failed with error: %v", err)
+			return err
+		}
+		vmNames = append(vmNames, vmName)
+		providerIDs = append(providerIDs, instance.Name)
+	}
+
+	if len(vmNames) == 0 {
+		klog.V(3).Infof("No new instances eligible for deletion, skipping")
+		return nil
+	}
+
+	scaleSet.markInstancesDeleting(providerIDs)
+
+	sem := make(chan struct{}, flexibleDeleteConcurrency)
+	var wg sync.WaitGroup
+	for i, vmName := range vmNames {
+		providerID := providerIDs[i]
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(vmName, providerID string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			ctx, cancel := getContextWithTimeout(vmssContextTimeout)
+			defer cancel()
+
+			scaleSet.instanceMutex.Lock()
+			future, err := scaleSet.manager.azClient.virtualMachinesClient.DeleteAsync(ctx, resourceGroup, vmName)
+			scaleSet.instanceMutex.Unlock()
+			if err != nil {
+				klog.Errorf("virtualMachinesClient.DeleteAsync for instance %s failed: %v", vmName, err)
+				scaleSet.unmarkInstancesDeleting([]string{providerID})
+				return
+			}
+
+			scaleSet.sizeMutex.Lock()
+			scaleSet.curSize--
+			scaleSet.sizeMutex.Unlock()
+
+			scaleSet.waitForDeleteFlexibleInstance(future, vmName, providerID)
+		}(vmName, providerID)
+	}
+	wg.Wait()
+
+	return nil
+}
+
+// waitForDeleteFlexibleInstance waits for a single Flexible mode VM delete to
+// complete and clears its in-flight deletion marker once it does.
+func (scaleSet *ScaleSet) waitForDeleteFlexibleInstance(future compute.VirtualMachinesDeleteFuture, vmName, providerID string) {
+	defer scaleSet.unmarkInstancesDeleting([]string{providerID})
+
+	ctx, cancel := getContextWithCancel()
+	defer cancel()
+	klog.V(3).Infof("Calling virtualMachinesClient.WaitForDelete(%s)", vmName)
+	resp, err := scaleSet.manager.azClient.virtualMachinesClient.WaitForDelete(ctx, future)
+	isSuccess, err := isSuccessHTTPResponse(resp, err)
+	if isSuccess {
+		klog.V(3).Infof("virtualMachinesClient.WaitForDelete(%s) success", vmName)
+		return
+	}
+	klog.Errorf("virtualMachinesClient.WaitForDelete for instance %s failed with error: %v", vmName, err)
+}
+
 // DeleteNodes deletes the nodes from the group.
 func (scaleSet *ScaleSet) DeleteNodes(nodes []*apiv1.Node) error {
 	klog.V(8).Infof("Delete nodes requested: %q\n", nodes)
@@ -506,16 +809,47 @@ func (scaleSet *ScaleSet) TemplateNodeInfo() (*schedulernodeinfo.NodeInfo, error
 		return nil, err
 	}
 
+	// For Flexible orchestration mode, template.Sku still describes the
+	// default VM profile's SKU used when creating new members, so
+	// buildNodeFromTemplate needs no branching here even though member VMs
+	// themselves are standalone virtualMachines resources.
 	node, err := buildNodeFromTemplate(scaleSet.Name, template)
 	if err != nil {
 		return nil, err
 	}
+	addExtendedLocationLabels(node, template.ExtendedLocation)
 
 	nodeInfo := schedulernodeinfo.NewNodeInfo(cloudprovider.BuildKubeProxy(scaleSet.Name))
 	nodeInfo.SetNode(node)
 	return nodeInfo, nil
 }
 
+const (
+	// extendedLocationTypeLabel and extendedLocationNameLabel let scheduler
+	// simulation match pods carrying edge-zone node selectors against the
+	// synthesized template node, the same way it would against a real node
+	// kubelet-labeled by cloud-provider-azure.
+	extendedLocationTypeLabel = "kubernetes.azure.com/extended-location-type"
+	extendedLocationNameLabel = "kubernetes.azure.com/extended-location-name"
+)
+
+// addExtendedLocationLabels adds well-known edge-zone labels to node when
+// the VMSS it was synthesized from carries an ExtendedLocation, so that pods
+// with edge-zone node selectors correctly trigger scale-ups for that scale
+// set. It is a no-op for scale sets deployed to regular Azure regions.
+// compute.ExtendedLocation requires the 2021-11-01+ compute API version
+// imported above; it doesn't exist at the package's former 2018-10-01 pin.
+func addExtendedLocationLabels(node *apiv1.Node, extendedLocation *compute.ExtendedLocation) {
+	if extendedLocation == nil || extendedLocation.Name == nil || extendedLocation.Type == "" {
+		return
+	}
+	if node.Labels == nil {
+		node.Labels = map[string]string{}
+	}
+	node.Labels[extendedLocationTypeLabel] = string(extendedLocation.Type)
+	node.Labels[extendedLocationNameLabel] = *extendedLocation.Name
+}
+
 // Nodes returns a list of all nodes that belong to this node group.
 func (scaleSet *ScaleSet) Nodes() ([]cloudprovider.Instance, error) {
 	klog.V(4).Infof("Nodes: starts, scaleSet.Name: %s", scaleSet.Name)
@@ -534,24 +868,74 @@ func (scaleSet *ScaleSet) Nodes() ([]cloudprovider.Instance, error) {
 		return scaleSet.instanceCache, nil
 	}
 
-	klog.V(4).Infof("Nodes: starts to get VMSS VMs")
-	vms, err := scaleSet.GetScaleSetVms()
+	vmssInfo, err := scaleSet.getVMSSInfo()
 	if err != nil {
-		if isAzureRequestsThrottled(err) {
-			// Log a warning and update the instance refresh time so that it would retry after next vmssInstancesRefreshPeriod.
-			klog.Warningf("GetScaleSetVms() is throttled with message %v, would return the cached instances", err)
-			scaleSet.lastInstanceRefresh = time.Now()
-			return scaleSet.instanceCache, nil
-		}
 		return nil, err
 	}
 
-	scaleSet.instanceCache = buildInstanceCache(vms)
+	var instances []cloudprovider.Instance
+	if isFlexibleOrchestrationMode(vmssInfo) {
+		klog.V(4).Infof("Nodes: starts to get Flexible VMSS VMs")
+		vms, err := scaleSet.GetFlexibleScaleSetVms()
+		if err != nil {
+			if isAzureRequestsThrottled(err) {
+				klog.Warningf("GetFlexibleScaleSetVms() is throttled with message %v, would return the cached instances", err)
+				scaleSet.lastInstanceRefresh = time.Now()
+				return scaleSet.instanceCache, nil
+			}
+			return nil, err
+		}
+		instances = buildInstanceCacheFromVMs(vms)
+	} else {
+		klog.V(4).Infof("Nodes: starts to get VMSS VMs")
+		vms, err := scaleSet.GetScaleSetVms()
+		if err != nil {
+			if isAzureRequestsThrottled(err) {
+				// Log a warning and update the instance refresh time so that it would retry after next vmssInstancesRefreshPeriod.
+				klog.Warningf("GetScaleSetVms() is throttled with message %v, would return the cached instances", err)
+				scaleSet.lastInstanceRefresh = time.Now()
+				return scaleSet.instanceCache, nil
+			}
+			return nil, err
+		}
+		instances = buildInstanceCache(vms)
+	}
+
+	scaleSet.instanceCache = scaleSet.applyInFlightDeletions(instances)
 	scaleSet.lastInstanceRefresh = time.Now()
+	scaleSet.registerInstancesWithCache()
 	klog.V(4).Infof("Nodes: returns")
 	return scaleSet.instanceCache, nil
 }
 
+// registerInstancesWithCache records every cached instance's providerID
+// against this ScaleSet's name in the shared AzureCache, so
+// AzureManager.GetAsgForInstance can resolve it back to this node group.
+func (scaleSet *ScaleSet) registerInstancesWithCache() {
+	for _, instance := range scaleSet.instanceCache {
+		scaleSet.manager.azureCache.SetNodeGroupForInstance(instance.Id, scaleSet.Id())
+	}
+}
+
+// applyInFlightDeletions marks instances as InstanceDeleting if they have a
+// DeleteInstancesAsync dispatched that the VMSS List API hasn't caught up
+// with yet, so scale-down doesn't re-issue deletes for the same instances.
+func (scaleSet *ScaleSet) applyInFlightDeletions(instances []cloudprovider.Instance) []cloudprovider.Instance {
+	scaleSet.deletionsMutex.Lock()
+	defer scaleSet.deletionsMutex.Unlock()
+
+	if len(scaleSet.vmDeletionsInProgress) == 0 {
+		return instances
+	}
+
+	for i := range instances {
+		if _, deleting := scaleSet.vmDeletionsInProgress[instances[i].Id]; deleting {
+			instances[i].Status = &cloudprovider.InstanceStatus{State: cloudprovider.InstanceDeleting}
+		}
+	}
+	return instances
+}
+
 // Note that the GetScaleSetVms() results is not used directly because for the List endpoint,
 // their resource ID format is not consistent with Get endpoint
 func buildInstanceCache(vms []compute.VirtualMachineScaleSetVM) []cloudprovider.Instance {
@@ -579,6 +963,53 @@ func buildInstanceCache(vms []compute.VirtualMachineScaleSetVM) []cloudprovider.
 	return instances
 }
 
+// buildInstanceCacheFromVMs builds the instance cache for a Flexible
+// orchestration mode VMSS, whose members are standalone
+// Microsoft.Compute/virtualMachines resources rather than VMSS VM
+// sub-resources.
+func buildInstanceCacheFromVMs(vms []compute.VirtualMachine) []cloudprovider.Instance {
+	instances := []cloudprovider.Instance{}
+
+	for _, vm := range vms {
+		if vm.ID == nil || len(*vm.ID) == 0 {
+			continue
+		}
+
+		resourceID, err := convertResourceGroupNameToLower(*vm.ID)
+		if err != nil {
+			klog.Warningf("buildInstanceCacheFromVMs.convertResourceGroupNameToLower failed with error: %v", err)
+			continue
+		}
+
+		instances = append(instances, cloudprovider.Instance{
+			Id:     "azure://" + resourceID,
+			Status: instanceStatusFromStandaloneVM(vm),
+		})
+	}
+
+	return instances
+}
+
+// instanceStatusFromStandaloneVM converts the provisioning state of a
+// Flexible orchestration mode member VM to a cloudprovider.InstanceStatus.
+func instanceStatusFromStandaloneVM(vm compute.VirtualMachine) *cloudprovider.InstanceStatus {
+	if vm.ProvisioningState == nil {
+		return nil
+	}
+
+	status := &cloudprovider.InstanceStatus{}
+	switch *vm.ProvisioningState {
+	case string(compute.ProvisioningStateDeleting):
+		status.State = cloudprovider.InstanceDeleting
+	case string(compute.ProvisioningStateCreating):
+		status.State = cloudprovider.InstanceCreating
+	default:
+		status.State = cloudprovider.InstanceRunning
+	}
+
+	return status
+}
+
 func (scaleSet *ScaleSet) getInstanceByProviderID(providerID string) (cloudprovider.Instance, bool) {
 	scaleSet.instanceMutex.Lock()
 	defer scaleSet.instanceMutex.Unlock()
@@ -614,6 +1045,8 @@ func (scaleSet *ScaleSet) invalidateInstanceCache() {
 	// Set the instanceCache as outdated.
 	scaleSet.lastInstanceRefresh = time.Now().Add(-1 * vmssInstancesRefreshPeriod)
 	scaleSet.instanceMutex.Unlock()
+
+	scaleSet.manager.azureCache.InvalidateVMSSInstances(scaleSet.Name)
 }
 
 func (scaleSet *ScaleSet) invalidateStatusCacheWithLock() {
@@ -621,7 +1054,5 @@ func (scaleSet *ScaleSet) invalidateStatusCacheWithLock() {
 	scaleSet.lastSizeRefresh = time.Now().Add(-1 * scaleSet.sizeRefreshPeriod)
 	scaleSet.sizeMutex.Unlock()
 
-	scaleSetStatusCache.mutex.Lock()
-	scaleSetStatusCache.lastRefresh = time.Now().Add(-1 * scaleSet.sizeRefreshPeriod)
-	scaleSetStatusCache.mutex.Unlock()
+	scaleSet.manager.azureCache.InvalidateVMSS(scaleSet.manager.config.ResourceGroup)
 }
@@ -0,0 +1,70 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"testing"
+
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider"
+)
+
+func TestVmssRetryBackoffDefaultsStepsWhenRetriesUnset(t *testing.T) {
+	config := &Config{CloudProviderBackoffRetries: 0}
+
+	backoff := vmssRetryBackoff(config)
+
+	if backoff.Steps < 1 {
+		t.Fatalf("Steps = %d, want at least 1: wait.ExponentialBackoff never calls its condition function when Steps <= 0", backoff.Steps)
+	}
+}
+
+func TestVmssRetryBackoffHonorsConfiguredRetries(t *testing.T) {
+	config := &Config{CloudProviderBackoffRetries: 5}
+
+	backoff := vmssRetryBackoff(config)
+
+	if backoff.Steps != 5 {
+		t.Fatalf("Steps = %d, want 5", backoff.Steps)
+	}
+}
+
+func TestRegisterInstancesWithCacheResolvesGetAsgForInstance(t *testing.T) {
+	manager, err := CreateAzureManager(&Config{}, &azClient{})
+	if err != nil {
+		t.Fatalf("CreateAzureManager returned error: %v", err)
+	}
+
+	scaleSet := &ScaleSet{
+		azureRef: azureRef{Name: "agent-pool-1"},
+		manager:  manager,
+		instanceCache: []cloudprovider.Instance{
+			{Id: "azure:///subscriptions/sub/.../agent-pool-1_0"},
+		},
+	}
+	manager.RegisterNodeGroup(scaleSet)
+
+	scaleSet.registerInstancesWithCache()
+
+	ref := &azureRef{Name: "azure:///subscriptions/sub/.../agent-pool-1_0"}
+	asg, err := manager.GetAsgForInstance(ref)
+	if err != nil {
+		t.Fatalf("GetAsgForInstance returned error: %v", err)
+	}
+	if asg.Id() != scaleSet.Id() {
+		t.Fatalf("GetAsgForInstance resolved to %q, want %q", asg.Id(), scaleSet.Id())
+	}
+}
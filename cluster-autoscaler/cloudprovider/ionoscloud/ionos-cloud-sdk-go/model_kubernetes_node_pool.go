@@ -0,0 +1,33 @@
+/*
+ * CLOUD API
+ *
+ * An enterprise-grade Infrastructure is provided as a Service (IaaS) solution that can be managed through a browser-based \"Data Center Designer\" (DCD) tool or via an easy to use API.   The API allows you to perform a variety of management tasks such as spinning up additional servers, adding volumes, adjusting networking, and so forth. It is designed to allow users to leverage the same power and flexibility found within the DCD visual tool. Both tools are consistent with their concepts and lend well to making the experience smooth and intuitive.
+ *
+ * API version: 5.0
+ */
+
+package ionossdk
+
+// NOTE: like model_kubernetes_node_pool_annotation_selector.go, this file is
+// hand-written (unlike the rest of this package, which is OpenAPI-generated)
+// because NodePoolDiscoverer needs the node pool and its properties to reach
+// the annotations that drive selector matching, and no generated model for
+// either existed in this checkout.
+
+// KubernetesNodePool is a node pool within a Kubernetes cluster.
+type KubernetesNodePool struct {
+	// Id is the resource's unique identifier.
+	Id *string `json:"id,omitempty"`
+	// Properties holds the node pool's configurable attributes.
+	Properties *KubernetesNodePoolProperties `json:"properties,omitempty"`
+}
+
+// KubernetesNodePoolProperties are the configurable attributes of a
+// KubernetesNodePool.
+type KubernetesNodePoolProperties struct {
+	// NodeCount is the current number of nodes in this node pool.
+	NodeCount *int32 `json:"nodeCount,omitempty"`
+	// Annotations attached to the node pool, including any
+	// cluster-autoscaler discovery annotations.
+	Annotations []KubernetesNodePoolAnnotation `json:"annotations,omitempty"`
+}
@@ -0,0 +1,49 @@
+/*
+ * CLOUD API
+ *
+ * An enterprise-grade Infrastructure is provided as a Service (IaaS) solution that can be managed through a browser-based \"Data Center Designer\" (DCD) tool or via an easy to use API.   The API allows you to perform a variety of management tasks such as spinning up additional servers, adding volumes, adjusting networking, and so forth. It is designed to allow users to leverage the same power and flexibility found within the DCD visual tool. Both tools are consistent with their concepts and lend well to making the experience smooth and intuitive.
+ *
+ * API version: 5.0
+ */
+
+package ionossdk
+
+// NOTE: this file is hand-written (unlike the rest of this package, which is
+// OpenAPI-generated) to support annotation-based node pool auto-discovery.
+// It covers the KubernetesNodePoolAnnotation matching helpers; the node pool
+// lister and background refresher that use them to drive discovery live in
+// NodePoolDiscoverer (package ionoscloud). The CLI flag that turns the
+// selector on lives in cluster-autoscaler's main package, outside this
+// provider, and isn't added here.
+
+// FindNodePoolAnnotation returns the value of the annotation with the given
+// key, and whether it was present at all, among annotations.
+func FindNodePoolAnnotation(annotations []KubernetesNodePoolAnnotation, key string) (string, bool) {
+	for _, annotation := range annotations {
+		if annotation.Key != nil && *annotation.Key == key {
+			if annotation.Value == nil {
+				return "", true
+			}
+			return *annotation.Value, true
+		}
+	}
+	return "", false
+}
+
+// MatchesNodePoolAnnotationSelector reports whether annotations carries every
+// key in required, and, for keys with a non-empty required value, that the
+// annotation's value matches exactly. This underlies discovery selectors
+// like "k8s.io/cluster-autoscaler/enabled" (presence-only) combined with
+// "k8s.io/cluster-autoscaler/<cluster>/min"/"max" (value-bearing).
+func MatchesNodePoolAnnotationSelector(annotations []KubernetesNodePoolAnnotation, required map[string]string) bool {
+	for key, wantValue := range required {
+		gotValue, found := FindNodePoolAnnotation(annotations, key)
+		if !found {
+			return false
+		}
+		if wantValue != "" && gotValue != wantValue {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ionoscloud
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"k8s.io/klog"
+
+	ionossdk "k8s.io/autoscaler/cluster-autoscaler/cloudprovider/ionoscloud/ionos-cloud-sdk-go"
+)
+
+// NodePoolLister is the subset of the IONOS Cloud Kubernetes API client
+// NodePoolDiscoverer needs to enumerate a cluster's node pools.
+type NodePoolLister interface {
+	ListNodePools(clusterID string) ([]ionossdk.KubernetesNodePool, error)
+}
+
+// NodePoolConfig is the per-pool configuration NodePoolDiscoverer parses out
+// of a matching pool's annotations, ready to back a NodeGroup registration.
+type NodePoolConfig struct {
+	// PoolId identifies the node pool within its cluster.
+	PoolId string
+	// MinSize and MaxSize come from the pool's
+	// "k8s.io/cluster-autoscaler/<cluster>/min" and "/max" annotations, and
+	// default to 0 when the annotation is absent.
+	MinSize int
+	MaxSize int
+}
+
+// NodeGroupRegistry is implemented by whatever owns the provider's live node
+// group set. NodePoolDiscoverer calls RegisterNodeGroup/DeregisterNodeGroup
+// as pools start and stop matching the configured selector, so the set of
+// autoscaled node groups tracks the annotations live instead of only ever
+// growing.
+type NodeGroupRegistry interface {
+	RegisterNodeGroup(config NodePoolConfig) error
+	DeregisterNodeGroup(poolId string) error
+}
+
+// NodePoolDiscoverer finds node pools that opt into cluster-autoscaler
+// management via annotations (e.g. "k8s.io/cluster-autoscaler/enabled"),
+// instead of requiring every pool to be named explicitly on the command
+// line, and keeps registry's node groups in sync with them.
+type NodePoolDiscoverer struct {
+	client    NodePoolLister
+	registry  NodeGroupRegistry
+	clusterID string
+	selector  map[string]string
+
+	// registered tracks the pool IDs currently registered with registry, so
+	// Run can tell which ones need deregistering once they stop matching.
+	registered map[string]bool
+}
+
+// NewNodePoolDiscoverer creates a NodePoolDiscoverer that matches node pools
+// in clusterID against selector using MatchesNodePoolAnnotationSelector, and
+// registers/deregisters NodeGroups for matches via registry as Run polls.
+func NewNodePoolDiscoverer(client NodePoolLister, registry NodeGroupRegistry, clusterID string, selector map[string]string) *NodePoolDiscoverer {
+	return &NodePoolDiscoverer{
+		client:     client,
+		registry:   registry,
+		clusterID:  clusterID,
+		selector:   selector,
+		registered: map[string]bool{},
+	}
+}
+
+// minSizeAnnotationKey and maxSizeAnnotationKey are the annotations
+// NodePoolConfig's MinSize/MaxSize are parsed from, scoped to a cluster so
+// the same node pool can opt into autoscaling differently per cluster.
+func minSizeAnnotationKey(clusterID string) string {
+	return fmt.Sprintf("k8s.io/cluster-autoscaler/%s/min", clusterID)
+}
+
+func maxSizeAnnotationKey(clusterID string) string {
+	return fmt.Sprintf("k8s.io/cluster-autoscaler/%s/max", clusterID)
+}
+
+// Discover returns the configuration of node pools in clusterID whose
+// annotations satisfy the configured selector.
+func (d *NodePoolDiscoverer) Discover() ([]NodePoolConfig, error) {
+	pools, err := d.client.ListNodePools(d.clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list node pools for cluster %q: %v", d.clusterID, err)
+	}
+
+	var configs []NodePoolConfig
+	for _, pool := range pools {
+		if pool.Id == nil || pool.Properties == nil {
+			continue
+		}
+		annotations := pool.Properties.Annotations
+		if !ionossdk.MatchesNodePoolAnnotationSelector(annotations, d.selector) {
+			continue
+		}
+
+		minSize, err := parseSizeAnnotation(annotations, minSizeAnnotationKey(d.clusterID))
+		if err != nil {
+			klog.Errorf("node pool %q in cluster %q: %v", *pool.Id, d.clusterID, err)
+			continue
+		}
+		maxSize, err := parseSizeAnnotation(annotations, maxSizeAnnotationKey(d.clusterID))
+		if err != nil {
+			klog.Errorf("node pool %q in cluster %q: %v", *pool.Id, d.clusterID, err)
+			continue
+		}
+
+		configs = append(configs, NodePoolConfig{PoolId: *pool.Id, MinSize: minSize, MaxSize: maxSize})
+	}
+	return configs, nil
+}
+
+// parseSizeAnnotation looks up key among annotations and parses it as a
+// non-negative int, defaulting to 0 when the annotation is absent.
+func parseSizeAnnotation(annotations []ionossdk.KubernetesNodePoolAnnotation, key string) (int, error) {
+	value, found := ionossdk.FindNodePoolAnnotation(annotations, key)
+	if !found {
+		return 0, nil
+	}
+	size, err := strconv.Atoi(value)
+	if err != nil || size < 0 {
+		return 0, fmt.Errorf("annotation %q has invalid size %q", key, value)
+	}
+	return size, nil
+}
+
+// Run polls Discover every interval until stopCh is closed, registering a
+// NodeGroup for every newly-matching pool and deregistering any pool that
+// stops matching (selector no longer satisfied, or the pool disappeared
+// entirely), so the autoscaled node group set tracks the annotations live.
+func (d *NodePoolDiscoverer) Run(interval time.Duration, stopCh <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if configs, err := d.Discover(); err != nil {
+			klog.Errorf("node pool discovery failed for cluster %q: %v", d.clusterID, err)
+		} else {
+			d.reconcile(configs)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-stopCh:
+			return
+		}
+	}
+}
+
+// reconcile registers a NodeGroup for every config not already registered,
+// and deregisters every previously-registered pool missing from configs.
+func (d *NodePoolDiscoverer) reconcile(configs []NodePoolConfig) {
+	current := make(map[string]bool, len(configs))
+	for _, config := range configs {
+		current[config.PoolId] = true
+		if d.registered[config.PoolId] {
+			continue
+		}
+		if err := d.registry.RegisterNodeGroup(config); err != nil {
+			klog.Errorf("failed to register node group for pool %q in cluster %q: %v", config.PoolId, d.clusterID, err)
+			continue
+		}
+		d.registered[config.PoolId] = true
+	}
+
+	for poolID := range d.registered {
+		if current[poolID] {
+			continue
+		}
+		if err := d.registry.DeregisterNodeGroup(poolID); err != nil {
+			klog.Errorf("failed to deregister node group for pool %q in cluster %q: %v", poolID, d.clusterID, err)
+			continue
+		}
+		delete(d.registered, poolID)
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package estimator
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	schedulernodeinfo "k8s.io/kubernetes/pkg/scheduler/nodeinfo"
+)
+
+// NOTE: the NodeGroup annotation/CLI flag surface operators would use to
+// declare per-workload container targets lives in the config package, which
+// isn't part of this source tree/checkout and so isn't implemented here.
+
+// ContainerResourceEstimator computes headroom from a single named
+// container's resource requests, mirroring the HPA v2
+// ContainerResourceMetricSource: instead of summing a whole pod's requests,
+// it sums only the requests of the container named ContainerName for
+// Resource, so sidecar-heavy pods are sized off the container that actually
+// dominates capacity planning.
+type ContainerResourceEstimator struct {
+	// ContainerName is the name of the container whose requests are summed.
+	ContainerName string
+	// Resource is the resource (cpu or memory) being estimated.
+	Resource apiv1.ResourceName
+}
+
+// NewContainerResourceEstimator returns a ContainerResourceEstimator for the
+// given container name and resource.
+func NewContainerResourceEstimator(containerName string, resource apiv1.ResourceName) *ContainerResourceEstimator {
+	return &ContainerResourceEstimator{ContainerName: containerName, Resource: resource}
+}
+
+// ContainerRequest returns the requested quantity of Resource for the
+// container named ContainerName in pod, and whether that container was
+// found at all. A pod without a matching container contributes nothing.
+func (e *ContainerResourceEstimator) ContainerRequest(pod *apiv1.Pod) (resource.Quantity, bool) {
+	for _, container := range pod.Spec.Containers {
+		if container.Name != e.ContainerName {
+			continue
+		}
+		if quantity, ok := container.Resources.Requests[e.Resource]; ok {
+			return quantity, true
+		}
+		return resource.Quantity{}, true
+	}
+	return resource.Quantity{}, false
+}
+
+// TotalRequest sums ContainerRequest across pods, skipping pods that don't
+// have a container named ContainerName.
+func (e *ContainerResourceEstimator) TotalRequest(pods []*apiv1.Pod) resource.Quantity {
+	total := resource.Quantity{}
+	for _, pod := range pods {
+		if quantity, found := e.ContainerRequest(pod); found {
+			total.Add(quantity)
+		}
+	}
+	return total
+}
+
+// Estimate implements Estimator: it reports how many of newPods'
+// ContainerName container would fit on nodeInfo before exhausting Resource,
+// treating every pod in newPods as requesting the same amount (the same
+// simplifying assumption BinpackingNodeEstimator makes for a single pod
+// equivalence group). If newPods[0] has no ContainerName container, or
+// requests none of Resource, every pod in newPods is counted as fitting;
+// otherwise a later pod missing that container is still counted at
+// newPods[0]'s request.
+func (e *ContainerResourceEstimator) Estimate(newPods []*apiv1.Pod, nodeInfo *schedulernodeinfo.NodeInfo) int {
+	if len(newPods) == 0 {
+		return 0
+	}
+
+	perPod, found := e.ContainerRequest(newPods[0])
+	if !found || perPod.IsZero() {
+		return len(newPods)
+	}
+
+	node := nodeInfo.Node()
+	if node == nil {
+		return 0
+	}
+	available := node.Status.Allocatable[e.Resource].DeepCopy()
+	available.Sub(e.TotalRequest(nodeInfo.Pods()))
+	if available.Sign() <= 0 {
+		return 0
+	}
+
+	count := 0
+	for available.Cmp(perPod) >= 0 && count < len(newPods) {
+		available.Sub(perPod)
+		count++
+	}
+	return count
+}